@@ -1,6 +1,11 @@
 package aggregator
 
-import "context"
+import (
+	"context"
+	"math/big"
+
+	"github.com/multiversx/mx-sdk-go/data"
+)
 
 // ResponseGetter is the component able to execute a get operation on the provided URL
 type ResponseGetter interface {
@@ -40,6 +45,11 @@ type ArgsPriceChanged struct {
 	Timestamp        int64
 }
 
+// DenominatedPriceAmount returns the denominated price as an exact amount, expressed in the quote token
+func (args *ArgsPriceChanged) DenominatedPriceAmount() *data.Amount {
+	return data.NewAmount(big.NewInt(0).SetUint64(args.DenominatedPrice), uint32(args.Decimals), args.Quote)
+}
+
 // PriceNotifee defines the behavior of a component able to be notified over a price change
 type PriceNotifee interface {
 	PriceChanged(ctx context.Context, priceChanges []*ArgsPriceChanged) error
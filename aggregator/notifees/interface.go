@@ -11,6 +11,7 @@ import (
 // TxBuilder defines the component able to build & sign a transaction
 type TxBuilder interface {
 	ApplyUserSignature(cryptoHolder core.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error
+	ApplyUserSignatureWithSigner(txSigner core.TransactionSigner, tx *transaction.FrontendTransaction) error
 	IsInterfaceNil() bool
 }
 
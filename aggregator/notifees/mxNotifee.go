@@ -25,6 +25,8 @@ type ArgsMxNotifee struct {
 	TxNonceHandler  TransactionNonceHandler
 	ContractAddress core.AddressHandler
 	CryptoHolder    core.CryptoComponentsHolder
+	// TxSigner is optional, when provided it is used instead of the CryptoHolder, so the private key is not needed
+	TxSigner        core.TransactionSigner
 	BaseGasLimit    uint64
 	GasLimitForEach uint64
 }
@@ -37,6 +39,7 @@ type mxNotifee struct {
 	baseGasLimit    uint64
 	gasLimitForEach uint64
 	cryptoHolder    core.CryptoComponentsHolder
+	txSigner        core.TransactionSigner
 }
 
 // NewMxNotifee will create a new instance of mxNotifee
@@ -54,6 +57,7 @@ func NewMxNotifee(args ArgsMxNotifee) (*mxNotifee, error) {
 		baseGasLimit:    args.BaseGasLimit,
 		gasLimitForEach: args.GasLimitForEach,
 		cryptoHolder:    args.CryptoHolder,
+		txSigner:        args.TxSigner,
 	}
 
 	return notifee, nil
@@ -75,7 +79,7 @@ func checkArgsMxNotifee(args ArgsMxNotifee) error {
 	if !args.ContractAddress.IsValid() {
 		return errInvalidContractAddress
 	}
-	if check.IfNil(args.CryptoHolder) {
+	if check.IfNil(args.CryptoHolder) && check.IfNil(args.TxSigner) {
 		return builders.ErrNilCryptoComponentsHolder
 	}
 	if args.BaseGasLimit < minGasLimit {
@@ -117,12 +121,12 @@ func (en *mxNotifee) PriceChanged(ctx context.Context, priceChanges []*aggregato
 		Version:  txVersion,
 	}
 
-	err = en.txNonceHandler.ApplyNonceAndGasPrice(ctx, en.cryptoHolder.GetAddressHandler(), tx)
+	err = en.txNonceHandler.ApplyNonceAndGasPrice(ctx, en.senderAddress(), tx)
 	if err != nil {
 		return err
 	}
 
-	err = en.txBuilder.ApplyUserSignature(en.cryptoHolder, tx)
+	err = en.applyUserSignature(tx)
 	if err != nil {
 		return err
 	}
@@ -137,6 +141,22 @@ func (en *mxNotifee) PriceChanged(ctx context.Context, priceChanges []*aggregato
 	return nil
 }
 
+func (en *mxNotifee) senderAddress() core.AddressHandler {
+	if !check.IfNil(en.txSigner) {
+		return en.txSigner.GetAddressHandler()
+	}
+
+	return en.cryptoHolder.GetAddressHandler()
+}
+
+func (en *mxNotifee) applyUserSignature(tx *transaction.FrontendTransaction) error {
+	if !check.IfNil(en.txSigner) {
+		return en.txBuilder.ApplyUserSignatureWithSigner(en.txSigner, tx)
+	}
+
+	return en.txBuilder.ApplyUserSignature(en.cryptoHolder, tx)
+}
+
 func (en *mxNotifee) prepareTxData(priceChanges []*aggregator.ArgsPriceChanged) ([]byte, error) {
 	txDataBuilder := builders.NewTxDataBuilder()
 	txDataBuilder.Function(function)
@@ -356,4 +356,37 @@ func TestMxNotifee_PriceChanged(t *testing.T) {
 		assert.Nil(t, err)
 		assert.True(t, sentWasCalled)
 	})
+	t.Run("should work with transaction signer", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsMxNotifeeWithSomeRealComponents()
+		holder := args.CryptoHolder
+		args.CryptoHolder = nil
+		signCallbackCalled := false
+		args.TxSigner, _ = cryptoProvider.NewCallbackTransactionSigner(
+			holder.GetAddressHandler().AddressBytes(),
+			func(payload []byte) ([]byte, error) {
+				signCallbackCalled = true
+				return cryptoProvider.NewSigner().SignByteSlice(payload, holder.GetPrivateKey())
+			},
+		)
+		args.TxNonceHandler = &testsCommon.TxNonceHandlerV2Stub{
+			ApplyNonceAndGasPriceCalled: func(ctx context.Context, address core.AddressHandler, tx *transaction.FrontendTransaction) error {
+				assert.Equal(t, holder.GetAddressHandler().AddressBytes(), address.AddressBytes())
+				return nil
+			},
+			SendTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (string, error) {
+				assert.Equal(t, "erd1p5jgz605m47fq5mlqklpcjth9hdl3au53dg8a5tlkgegfnep3d7stdk09x", tx.Sender)
+				assert.NotEmpty(t, tx.Signature)
+				return "hash", nil
+			},
+		}
+
+		en, err := NewMxNotifee(args)
+		require.Nil(t, err)
+
+		err = en.PriceChanged(context.Background(), createMockPriceChanges())
+		assert.Nil(t, err)
+		assert.True(t, signCallbackCalled)
+	})
 }
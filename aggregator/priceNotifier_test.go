@@ -342,3 +342,18 @@ func TestPriceNotifier_Execute(t *testing.T) {
 		assert.Equal(t, 2, numCalled)
 	})
 }
+
+func TestArgsPriceChanged_DenominatedPriceAmount(t *testing.T) {
+	t.Parallel()
+
+	args := &aggregator.ArgsPriceChanged{
+		Base:             "EGLD",
+		Quote:            "USD",
+		DenominatedPrice: 4267,
+		Decimals:         2,
+	}
+
+	amount := args.DenominatedPriceAmount()
+	assert.Equal(t, "42.67 USD", amount.String())
+	assert.Equal(t, uint32(2), amount.Decimals())
+}
@@ -20,26 +20,29 @@ type ArgsNativeAuthClient struct {
 	Proxy                  workflows.ProxyHandler
 	CryptoComponentsHolder core.CryptoComponentsHolder
 	TokenHandler           authentication.AuthTokenHandler
-	TokenExpiryInSeconds   int64
-	Host                   string
+	// TransactionSigner is optional, when provided it is used instead of the Signer and the CryptoComponentsHolder,
+	// so the private key is not needed
+	TransactionSigner    core.TransactionSigner
+	TokenExpiryInSeconds int64
+	Host                 string
 }
 
 type authClient struct {
-	signer                 builders.Signer
-	extraInfo              []byte
-	proxy                  workflows.ProxyHandler
-	cryptoComponentsHolder core.CryptoComponentsHolder
-	tokenExpiryInSeconds   int64
-	host                   []byte
-	token                  string
-	tokenHandler           authentication.AuthTokenHandler
-	tokenExpire            time.Time
-	getTimeHandler         func() time.Time
+	txSigner             core.TransactionSigner
+	extraInfo            []byte
+	proxy                workflows.ProxyHandler
+	tokenExpiryInSeconds int64
+	host                 []byte
+	token                string
+	tokenHandler         authentication.AuthTokenHandler
+	tokenExpire          time.Time
+	getTimeHandler       func() time.Time
 }
 
 // NewNativeAuthClient will create a new native client able to create authentication tokens
 func NewNativeAuthClient(args ArgsNativeAuthClient) (*authClient, error) {
-	if check.IfNil(args.Signer) {
+	hasTransactionSigner := !check.IfNil(args.TransactionSigner)
+	if !hasTransactionSigner && check.IfNil(args.Signer) {
 		return nil, authentication.ErrNilSigner
 	}
 
@@ -56,19 +59,22 @@ func NewNativeAuthClient(args ArgsNativeAuthClient) (*authClient, error) {
 		return nil, authentication.ErrNilTokenHandler
 	}
 
-	if check.IfNil(args.CryptoComponentsHolder) {
-		return nil, authentication.ErrNilCryptoComponentsHolder
+	txSigner := args.TransactionSigner
+	if !hasTransactionSigner {
+		txSigner, err = builders.NewCryptoHolderTransactionSigner(args.Signer, args.CryptoComponentsHolder)
+		if err != nil {
+			return nil, authentication.ErrNilCryptoComponentsHolder
+		}
 	}
 
 	return &authClient{
-		signer:                 args.Signer,
-		extraInfo:              extraInfoBytes,
-		proxy:                  args.Proxy,
-		cryptoComponentsHolder: args.CryptoComponentsHolder,
-		host:                   []byte(args.Host),
-		tokenHandler:           args.TokenHandler,
-		tokenExpiryInSeconds:   args.TokenExpiryInSeconds,
-		getTimeHandler:         time.Now,
+		txSigner:             txSigner,
+		extraInfo:            extraInfoBytes,
+		proxy:                args.Proxy,
+		host:                 []byte(args.Host),
+		tokenHandler:         args.TokenHandler,
+		tokenExpiryInSeconds: args.TokenExpiryInSeconds,
+		getTimeHandler:       time.Now,
 	}, nil
 }
 
@@ -102,12 +108,12 @@ func (nac *authClient) createNewToken() error {
 		host:      nac.host,
 		extraInfo: nac.extraInfo,
 		blockHash: lastHyperblock.Hash,
-		address:   []byte(nac.cryptoComponentsHolder.GetBech32()),
+		address:   []byte(nac.txSigner.GetBech32()),
 	}
 
 	unsignedToken := nac.tokenHandler.GetUnsignedToken(token)
 	signableMessage := nac.tokenHandler.GetSignableMessage(token.GetAddress(), unsignedToken)
-	token.signature, err = nac.signer.SignMessage(signableMessage, nac.cryptoComponentsHolder.GetPrivateKey())
+	token.signature, err = nac.txSigner.SignMessage(signableMessage)
 	if err != nil {
 		return err
 	}
@@ -1,6 +1,7 @@
 package native
 
 import (
+	"bytes"
 	"context"
 	"testing"
 	"time"
@@ -8,6 +9,7 @@ import (
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-sdk-go/authentication"
 	"github.com/multiversx/mx-sdk-go/authentication/native/mock"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
 	"github.com/multiversx/mx-sdk-go/data"
 	"github.com/multiversx/mx-sdk-go/testsCommon"
 	"github.com/multiversx/mx-sdk-go/workflows"
@@ -193,6 +195,38 @@ func TestNativeAuthClient_GetAccessToken(t *testing.T) {
 		require.Nil(t, err)
 		require.Equal(t, expectedToken, token)
 	})
+	t.Run("should work with transaction signer", func(t *testing.T) {
+		t.Parallel()
+
+		expectedSignature := []byte("signature")
+		args := createMockArgsNativeAuthClient()
+		args.Signer = nil
+		args.CryptoComponentsHolder = nil
+		args.TransactionSigner, _ = cryptoProvider.NewCallbackTransactionSigner(
+			bytes.Repeat([]byte{1}, 32),
+			func(payload []byte) ([]byte, error) {
+				return expectedSignature, nil
+			},
+		)
+		args.Proxy = &testsCommon.ProxyStub{
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+				return &data.HyperBlock{Hash: "hash"}, nil
+			},
+		}
+		args.TokenHandler = &mock.AuthTokenHandlerStub{
+			EncodeCalled: func(authToken authentication.AuthToken) (string, error) {
+				require.Equal(t, expectedSignature, authToken.GetSignature())
+				require.Equal(t, []byte("erd1qyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqszqgpqyqsl6e0p7"), authToken.GetAddress())
+				return "token", nil
+			},
+		}
+		client, err := NewNativeAuthClient(args)
+		require.Nil(t, err)
+
+		token, err := client.GetAccessToken()
+		require.Nil(t, err)
+		require.Equal(t, "token", token)
+	})
 	t.Run("should work, token expired should generate new one", func(t *testing.T) {
 		t.Parallel()
 
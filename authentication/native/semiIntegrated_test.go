@@ -13,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-go/testscommon"
 	"github.com/multiversx/mx-sdk-go/authentication"
 	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/data"
 	"github.com/multiversx/mx-sdk-go/examples"
 	"github.com/multiversx/mx-sdk-go/interactors"
@@ -48,8 +49,8 @@ func TestNativeserver_ClientServer(t *testing.T) {
 			},
 		}
 		tokenHandler := NewAuthTokenHandler()
-		server := createNativeServer(httpClientWrapper, tokenHandler)
-		alice := createNativeClient(examples.AlicePemContents, proxy, tokenHandler, "host")
+		server := createNativeServer(httpClientWrapper, tokenHandler, hrp)
+		alice := createNativeClient(examples.AlicePemContents, proxy, tokenHandler, "host", nil)
 
 		authToken, _ := alice.GetAccessToken()
 
@@ -58,12 +59,57 @@ func TestNativeserver_ClientServer(t *testing.T) {
 		err = server.Validate(tokenDecoded)
 		require.Nil(t, err)
 	})
+	t.Run("valid token with custom address prefix", func(t *testing.T) {
+		t.Parallel()
+		lastBlock := &data.HyperBlock{
+			Timestamp: uint64(time.Now().Unix()),
+			Hash:      "hash",
+		}
+		proxy := &testsCommon.ProxyStub{
+			GetHyperBlockByNonceCalled: func(ctx context.Context, nonce uint64) (*data.HyperBlock, error) {
+				return lastBlock, nil
+			},
+		}
+
+		httpClientWrapper := &testsCommon.HTTPClientWrapperStub{
+			GetHTTPCalled: func(ctx context.Context, endpoint string) ([]byte, int, error) {
+				block := &data.Block{
+					Timestamp: int(lastBlock.Timestamp),
+				}
+				buff, _ := json.Marshal(block)
+				return buff, http.StatusOK, nil
+			},
+		}
+		tokenHandler := NewAuthTokenHandler()
+		converter, _ := sdkCore.NewAddressPublicKeyConverter("sov")
+		alice := createNativeClient(examples.AlicePemContents, proxy, tokenHandler, "host", converter)
+
+		authToken, _ := alice.GetAccessToken()
+
+		tokenDecoded, err := tokenHandler.Decode(authToken)
+		require.Nil(t, err)
+		require.Equal(t, "sov1", string(tokenDecoded.GetAddress()[:4]))
+
+		server := createNativeServer(httpClientWrapper, tokenHandler, "sov")
+		err = server.Validate(tokenDecoded)
+		require.Nil(t, err)
+
+		defaultServer := createNativeServer(httpClientWrapper, tokenHandler, hrp)
+		err = defaultServer.Validate(tokenDecoded)
+		require.NotNil(t, err)
+	})
 }
 
-func createNativeClient(pem string, proxy workflows.ProxyHandler, tokenHandler authentication.AuthTokenHandler, host string) *authClient {
-	w := interactors.NewWallet()
+func createNativeClient(
+	pem string,
+	proxy workflows.ProxyHandler,
+	tokenHandler authentication.AuthTokenHandler,
+	host string,
+	addressConverter sdkCore.AddressConverter,
+) *authClient {
+	w := interactors.NewWalletWithAddressConverter(addressConverter)
 	privateKeyBytes, _ := w.LoadPrivateKeyFromPemData([]byte(pem))
-	cryptoCompHolder, _ := cryptoProvider.NewCryptoComponentsHolder(keyGen, privateKeyBytes)
+	cryptoCompHolder, _ := cryptoProvider.NewCryptoComponentsHolderWithAddressConverter(keyGen, privateKeyBytes, addressConverter)
 
 	clientArgs := ArgsNativeAuthClient{
 		Signer:                 cryptoProvider.NewSigner(),
@@ -79,7 +125,7 @@ func createNativeClient(pem string, proxy workflows.ProxyHandler, tokenHandler a
 	return client
 }
 
-func createNativeServer(httpClientWrapper authentication.HttpClientWrapper, tokenHandler authentication.AuthTokenHandler) *authServer {
+func createNativeServer(httpClientWrapper authentication.HttpClientWrapper, tokenHandler authentication.AuthTokenHandler, hrp string) *authServer {
 	converter, _ := pubkeyConverter.NewBech32PubkeyConverter(32, hrp)
 
 	serverArgs := ArgsNativeAuthServer{
@@ -104,3 +104,8 @@ func (ag *addressGenerator) ComputeWasmVMScAddress(address core.AddressHandler,
 
 	return data.NewAddressFromBytes(scAddressBytes), nil
 }
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (ag *addressGenerator) IsInterfaceNil() bool {
+	return ag == nil
+}
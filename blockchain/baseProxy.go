@@ -26,6 +26,7 @@ type argsBaseProxy struct {
 	expirationTime    time.Duration
 	httpClientWrapper httpClientWrapper
 	endpointProvider  EndpointProvider
+	addressConverter  core.AddressConverter
 }
 
 type baseProxy struct {
@@ -36,6 +37,7 @@ type baseProxy struct {
 	cacheExpiryDuration time.Duration
 	sinceTimeHandler    func(t time.Time) time.Duration
 	endpointProvider    EndpointProvider
+	addressConverter    core.AddressConverter
 }
 
 // newBaseProxy will create a base multiversx proxy with cache instance
@@ -49,6 +51,7 @@ func newBaseProxy(args argsBaseProxy) (*baseProxy, error) {
 		httpClientWrapper:   args.httpClientWrapper,
 		cacheExpiryDuration: args.expirationTime,
 		endpointProvider:    args.endpointProvider,
+		addressConverter:    args.addressConverter,
 		sinceTimeHandler:    since,
 	}, nil
 }
@@ -63,6 +66,9 @@ func checkArgsBaseProxy(args argsBaseProxy) error {
 	if check.IfNil(args.endpointProvider) {
 		return ErrNilEndpointProvider
 	}
+	if check.IfNil(args.addressConverter) {
+		return ErrNilAddressConverter
+	}
 
 	return nil
 }
@@ -136,7 +142,7 @@ func (proxy *baseProxy) getNetworkConfigFromSource(ctx context.Context) (*data.N
 // GetShardOfAddress returns the shard ID of a provided address by using a shardCoordinator object and querying the
 // network config route
 func (proxy *baseProxy) GetShardOfAddress(ctx context.Context, bech32Address string) (uint32, error) {
-	addr, err := data.NewAddressFromBech32String(bech32Address)
+	addr, err := data.NewAddressFromBech32StringWithConverter(bech32Address, proxy.addressConverter)
 	if err != nil {
 		return 0, err
 	}
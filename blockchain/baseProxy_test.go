@@ -13,6 +13,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-sdk-go/blockchain/endpointProviders"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/data"
 	"github.com/multiversx/mx-sdk-go/testsCommon"
 	"github.com/stretchr/testify/assert"
@@ -24,6 +25,7 @@ func createMockArgsBaseProxy() argsBaseProxy {
 		httpClientWrapper: &testsCommon.HTTPClientWrapperStub{},
 		expirationTime:    time.Second,
 		endpointProvider:  endpointProviders.NewNodeEndpointProvider(),
+		addressConverter:  sdkCore.AddressPublicKeyConverter,
 	}
 }
 
@@ -60,6 +62,16 @@ func TestNewBaseProxy(t *testing.T) {
 		assert.True(t, check.IfNil(baseProxyInstance))
 		assert.True(t, errors.Is(err, ErrNilEndpointProvider))
 	})
+	t.Run("nil address converter", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsBaseProxy()
+		args.addressConverter = nil
+		baseProxyInstance, err := newBaseProxy(args)
+
+		assert.True(t, check.IfNil(baseProxyInstance))
+		assert.True(t, errors.Is(err, ErrNilAddressConverter))
+	})
 	t.Run("should work", func(t *testing.T) {
 		t.Parallel()
 
@@ -0,0 +1,79 @@
+package cryptoProvider
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// SignCallback signs the provided payload with the private key matching the signer's public key. The payload is
+// either the transaction's data for signing or the serialized message
+type SignCallback func(payload []byte) ([]byte, error)
+
+// callbackTransactionSigner is a transaction signer that only knows the public key and delegates the signing to a
+// callback, so the private key can live in a KMS, an HSM or a separate process
+type callbackTransactionSigner struct {
+	address      core.AddressHandler
+	bech32       string
+	signCallback SignCallback
+}
+
+// NewCallbackTransactionSigner creates a new transaction signer from a public key and a sign callback
+func NewCallbackTransactionSigner(publicKey []byte, signCallback SignCallback) (*callbackTransactionSigner, error) {
+	return NewCallbackTransactionSignerWithAddressConverter(publicKey, signCallback, core.AddressPublicKeyConverter)
+}
+
+// NewCallbackTransactionSignerWithAddressConverter creates a new transaction signer from a public key and a sign
+// callback that encodes its bech32 address with the provided address converter. A nil converter means the default
+// erd prefixed one
+func NewCallbackTransactionSignerWithAddressConverter(
+	publicKey []byte,
+	signCallback SignCallback,
+	addressConverter core.AddressConverter,
+) (*callbackTransactionSigner, error) {
+	if len(publicKey) != core.AddressBytesLen {
+		return nil, fmt.Errorf("%w: expected %d bytes, got %d", ErrInvalidPublicKey, core.AddressBytesLen, len(publicKey))
+	}
+	if signCallback == nil {
+		return nil, ErrNilSignCallback
+	}
+
+	address := data.NewAddressFromBytesWithConverter(publicKey, addressConverter)
+	bech32, err := address.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	return &callbackTransactionSigner{
+		address:      address,
+		bech32:       bech32,
+		signCallback: signCallback,
+	}, nil
+}
+
+// GetBech32 returns the bech32 address of the signer
+func (callbackSigner *callbackTransactionSigner) GetBech32() string {
+	return callbackSigner.bech32
+}
+
+// GetAddressHandler returns the address of the signer
+func (callbackSigner *callbackTransactionSigner) GetAddressHandler() core.AddressHandler {
+	return callbackSigner.address
+}
+
+// SignTransaction signs the data computed from the transaction
+func (callbackSigner *callbackTransactionSigner) SignTransaction(_ *transaction.FrontendTransaction, dataForSigning []byte) ([]byte, error) {
+	return callbackSigner.signCallback(dataForSigning)
+}
+
+// SignMessage signs the message serialized in the same way as the signer's SignMessage does
+func (callbackSigner *callbackTransactionSigner) SignMessage(message []byte) ([]byte, error) {
+	return callbackSigner.signCallback(serializeMessageForSigning(LegacyMessagePrefix, message))
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (callbackSigner *callbackTransactionSigner) IsInterfaceNil() bool {
+	return callbackSigner == nil
+}
@@ -0,0 +1,67 @@
+package cryptoProvider
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewCallbackTransactionSigner(t *testing.T) {
+	t.Parallel()
+
+	signCallback := func(payload []byte) ([]byte, error) {
+		return payload, nil
+	}
+
+	t.Run("invalid public key should error", func(t *testing.T) {
+		t.Parallel()
+
+		txSigner, err := NewCallbackTransactionSigner(make([]byte, 31), signCallback)
+		assert.True(t, check.IfNil(txSigner))
+		assert.True(t, errors.Is(err, ErrInvalidPublicKey))
+	})
+	t.Run("nil sign callback should error", func(t *testing.T) {
+		t.Parallel()
+
+		txSigner, err := NewCallbackTransactionSigner(make([]byte, 32), nil)
+		assert.True(t, check.IfNil(txSigner))
+		assert.Equal(t, ErrNilSignCallback, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		txSigner, err := NewCallbackTransactionSigner(make([]byte, 32), signCallback)
+		assert.False(t, check.IfNil(txSigner))
+		assert.Nil(t, err)
+		assert.Equal(t, "erd1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq6gq4hu", txSigner.GetBech32())
+	})
+}
+
+func TestCallbackTransactionSigner_Sign(t *testing.T) {
+	t.Parallel()
+
+	sk, _ := hex.DecodeString("6ae10fed53a84029e53e35afdbe083688eea0917a09a9431951dd42fd4da14c4")
+	holder, _ := NewCryptoComponentsHolder(messageKeyGenerator, sk)
+	localSigner := NewSigner()
+	txSigner, err := NewCallbackTransactionSigner(
+		holder.GetAddressHandler().AddressBytes(),
+		func(payload []byte) ([]byte, error) {
+			return localSigner.SignByteSlice(payload, holder.GetPrivateKey())
+		},
+	)
+	require.Nil(t, err)
+	assert.Equal(t, holder.GetBech32(), txSigner.GetBech32())
+
+	signature, err := txSigner.SignTransaction(nil, []byte("data for signing"))
+	require.Nil(t, err)
+	expectedSignature, _ := localSigner.SignByteSlice([]byte("data for signing"), holder.GetPrivateKey())
+	assert.Equal(t, expectedSignature, signature)
+
+	signature, err = txSigner.SignMessage([]byte("message"))
+	require.Nil(t, err)
+	assert.Nil(t, localSigner.VerifyMessage([]byte("message"), holder.GetPublicKey(), signature))
+}
@@ -15,6 +15,16 @@ type cryptoComponentsHolder struct {
 
 // NewCryptoComponentsHolder returns a new cryptoComponentsHolder instance
 func NewCryptoComponentsHolder(keyGen crypto.KeyGenerator, skBytes []byte) (*cryptoComponentsHolder, error) {
+	return NewCryptoComponentsHolderWithAddressConverter(keyGen, skBytes, core.AddressPublicKeyConverter)
+}
+
+// NewCryptoComponentsHolderWithAddressConverter returns a new cryptoComponentsHolder instance that encodes its
+// bech32 address with the provided address converter. A nil converter means the default erd prefixed one
+func NewCryptoComponentsHolderWithAddressConverter(
+	keyGen crypto.KeyGenerator,
+	skBytes []byte,
+	addressConverter core.AddressConverter,
+) (*cryptoComponentsHolder, error) {
 	privateKey, err := keyGen.PrivateKeyFromByteArray(skBytes)
 	if err != nil {
 		return nil, err
@@ -24,7 +34,7 @@ func NewCryptoComponentsHolder(keyGen crypto.KeyGenerator, skBytes []byte) (*cry
 	if err != nil {
 		return nil, err
 	}
-	addressHandler := data.NewAddressFromBytes(publicKeyBytes)
+	addressHandler := data.NewAddressFromBytesWithConverter(publicKeyBytes, addressConverter)
 	bech32Address, err := addressHandler.AddressAsBech32String()
 	if err != nil {
 		return nil, err
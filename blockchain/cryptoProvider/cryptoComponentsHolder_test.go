@@ -7,6 +7,7 @@ import (
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-go/testscommon/cryptoMocks"
+	"github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/testsCommon"
 	"github.com/stretchr/testify/require"
 )
@@ -93,4 +94,22 @@ func TestNewCryptoComponentsHolder(t *testing.T) {
 		require.Equal(t, addressAsBech32String, bech32Address)
 		require.Equal(t, "erd1j84k44nsqsme8r6e5aawutx0z2cd6cyx3wprkzdh73x2cf0kqvksa3snnq", bech32Address)
 	})
+	t.Run("should work with a custom address converter", func(t *testing.T) {
+		t.Parallel()
+
+		converter, _ := core.NewAddressPublicKeyConverter("sov")
+		sk, _ := hex.DecodeString("45f72e8b6e8d10086bacd2fc8fa1340f82a3f5d4ef31953b463ea03c606533a6")
+		holder, err := NewCryptoComponentsHolderWithAddressConverter(keyGen, sk, converter)
+		require.False(t, check.IfNil(holder))
+		require.Nil(t, err)
+
+		addressAsBech32String, err := holder.GetAddressHandler().AddressAsBech32String()
+		require.Nil(t, err)
+		require.Equal(t, holder.GetBech32(), addressAsBech32String)
+		require.Equal(t, "sov", addressAsBech32String[:3])
+
+		defaultHolder, _ := NewCryptoComponentsHolder(keyGen, sk)
+		require.Equal(t, defaultHolder.GetAddressHandler().AddressBytes(), holder.GetAddressHandler().AddressBytes())
+		require.Equal(t, "erd1j84k44nsqsme8r6e5aawutx0z2cd6cyx3wprkzdh73x2cf0kqvksa3snnq", defaultHolder.GetBech32())
+	})
 }
@@ -4,3 +4,30 @@ import "errors"
 
 // ErrTxAlreadySigned signals that the provided transaction is already signed
 var ErrTxAlreadySigned = errors.New("tx already signed")
+
+// ErrInvalidSignableMessage signals that the signable message is invalid
+var ErrInvalidSignableMessage = errors.New("invalid signable message")
+
+// ErrInvalidMessageSignature signals that the message signature does not match the message and the address
+var ErrInvalidMessageSignature = errors.New("invalid message signature")
+
+// ErrInvalidKeyringDirectory signals that the keyring directory is invalid
+var ErrInvalidKeyringDirectory = errors.New("invalid keyring directory")
+
+// ErrDuplicateKey signals that the same key was provided more than once
+var ErrDuplicateKey = errors.New("duplicate key")
+
+// ErrKeyNotFound signals that the key was not found
+var ErrKeyNotFound = errors.New("key not found")
+
+// ErrInvalidPublicKey signals that an invalid public key was provided
+var ErrInvalidPublicKey = errors.New("invalid public key")
+
+// ErrNilSignCallback signals that a nil sign callback was provided
+var ErrNilSignCallback = errors.New("nil sign callback")
+
+// ErrNilPemWallet signals that a nil pem wallet was provided
+var ErrNilPemWallet = errors.New("nil pem wallet")
+
+// ErrNilKeyGenerator signals that a nil key generator was provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
@@ -0,0 +1,10 @@
+package cryptoProvider
+
+import "github.com/multiversx/mx-sdk-go/data"
+
+// PemWallet defines the .pem files operations needed by the directory keyring
+type PemWallet interface {
+	LoadAllPrivateKeysFromPemFile(filename string) ([]*data.PemKey, error)
+	AppendPrivateKeyToPemFile(privateKey []byte, filename string) error
+	IsInterfaceNil() bool
+}
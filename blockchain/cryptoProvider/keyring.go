@@ -0,0 +1,188 @@
+package cryptoProvider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+const pemFilesPattern = "*.pem"
+
+// ArgsDirectoryKeyring is the DTO used in the directory keyring constructor
+type ArgsDirectoryKeyring struct {
+	Directory string
+	// Wallet loads and saves the .pem files, such as the wallet from the interactors package
+	Wallet       PemWallet
+	KeyGenerator crypto.KeyGenerator
+	// AddressConverter encodes the keys' bech32 addresses. A nil converter means the default erd prefixed one
+	AddressConverter core.AddressConverter
+}
+
+// directoryKeyring holds the wallet keys found in the .pem files (each one possibly holding more than one key) of a
+// directory. The keys are ordered by the file name and then by their position inside the file
+type directoryKeyring struct {
+	directory        string
+	wallet           PemWallet
+	keyGenerator     crypto.KeyGenerator
+	addressConverter core.AddressConverter
+
+	mut              sync.RWMutex
+	holders          []core.CryptoComponentsHolder
+	holdersByAddress map[string]core.CryptoComponentsHolder
+}
+
+// NewDirectoryKeyring creates a new keyring that loads all the keys from the .pem files of the provided directory.
+// The same key can not be found twice in the directory
+func NewDirectoryKeyring(args ArgsDirectoryKeyring) (*directoryKeyring, error) {
+	if check.IfNil(args.Wallet) {
+		return nil, ErrNilPemWallet
+	}
+	if check.IfNil(args.KeyGenerator) {
+		return nil, ErrNilKeyGenerator
+	}
+	info, err := os.Stat(args.Directory)
+	if err != nil {
+		return nil, err
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("%w: %s is not a directory", ErrInvalidKeyringDirectory, args.Directory)
+	}
+
+	keyring := &directoryKeyring{
+		directory:        args.Directory,
+		wallet:           args.Wallet,
+		keyGenerator:     args.KeyGenerator,
+		addressConverter: core.AddressConverterOrDefault(args.AddressConverter),
+		holders:          make([]core.CryptoComponentsHolder, 0),
+		holdersByAddress: make(map[string]core.CryptoComponentsHolder),
+	}
+
+	err = keyring.loadDirectory()
+	if err != nil {
+		return nil, err
+	}
+
+	return keyring, nil
+}
+
+func (keyring *directoryKeyring) loadDirectory() error {
+	filenames, err := filepath.Glob(filepath.Join(keyring.directory, pemFilesPattern))
+	if err != nil {
+		return err
+	}
+	sort.Strings(filenames)
+
+	for _, filename := range filenames {
+		keys, errLoad := keyring.wallet.LoadAllPrivateKeysFromPemFile(filename)
+		if errLoad != nil {
+			return fmt.Errorf("%w in file %s", errLoad, filename)
+		}
+
+		for _, key := range keys {
+			_, errAdd := keyring.addKey(key.PrivateKey)
+			if errAdd != nil {
+				return fmt.Errorf("%w in file %s", errAdd, filename)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (keyring *directoryKeyring) addKey(privateKey []byte) (core.CryptoComponentsHolder, error) {
+	holder, err := NewCryptoComponentsHolderWithAddressConverter(keyring.keyGenerator, privateKey, keyring.addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	_, exists := keyring.holdersByAddress[holder.GetBech32()]
+	if exists {
+		return nil, fmt.Errorf("%w: %s", ErrDuplicateKey, holder.GetBech32())
+	}
+
+	keyring.holders = append(keyring.holders, holder)
+	keyring.holdersByAddress[holder.GetBech32()] = holder
+
+	return holder, nil
+}
+
+// AddKey saves the private key in a new .pem file of the directory, named after the key's address, and adds it to the keyring
+func (keyring *directoryKeyring) AddKey(privateKey []byte) (core.CryptoComponentsHolder, error) {
+	keyring.mut.Lock()
+	defer keyring.mut.Unlock()
+
+	holder, err := keyring.addKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	filename := filepath.Join(keyring.directory, holder.GetBech32()+".pem")
+	err = keyring.wallet.AppendPrivateKeyToPemFile(privateKey, filename)
+	if err != nil {
+		keyring.removeLastKey()
+		return nil, err
+	}
+
+	return holder, nil
+}
+
+func (keyring *directoryKeyring) removeLastKey() {
+	lastIndex := len(keyring.holders) - 1
+	delete(keyring.holdersByAddress, keyring.holders[lastIndex].GetBech32())
+	keyring.holders = keyring.holders[:lastIndex]
+}
+
+// GetByIndex returns the key found at the provided index
+func (keyring *directoryKeyring) GetByIndex(index int) (core.CryptoComponentsHolder, error) {
+	keyring.mut.RLock()
+	defer keyring.mut.RUnlock()
+
+	if index < 0 || index >= len(keyring.holders) {
+		return nil, fmt.Errorf("%w: index %d, the keyring holds %d keys", ErrKeyNotFound, index, len(keyring.holders))
+	}
+
+	return keyring.holders[index], nil
+}
+
+// GetByAddress returns the key of the provided bech32 address
+func (keyring *directoryKeyring) GetByAddress(bech32Address string) (core.CryptoComponentsHolder, error) {
+	keyring.mut.RLock()
+	defer keyring.mut.RUnlock()
+
+	holder, found := keyring.holdersByAddress[bech32Address]
+	if !found {
+		return nil, fmt.Errorf("%w: address %s", ErrKeyNotFound, bech32Address)
+	}
+
+	return holder, nil
+}
+
+// GetAll returns all the keys
+func (keyring *directoryKeyring) GetAll() []core.CryptoComponentsHolder {
+	keyring.mut.RLock()
+	defer keyring.mut.RUnlock()
+
+	holders := make([]core.CryptoComponentsHolder, len(keyring.holders))
+	copy(holders, keyring.holders)
+
+	return holders
+}
+
+// Len returns the number of keys
+func (keyring *directoryKeyring) Len() int {
+	keyring.mut.RLock()
+	defer keyring.mut.RUnlock()
+
+	return len(keyring.holders)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (keyring *directoryKeyring) IsInterfaceNil() bool {
+	return keyring == nil
+}
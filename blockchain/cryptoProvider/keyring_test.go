@@ -0,0 +1,162 @@
+package cryptoProvider
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/examples"
+	"github.com/multiversx/mx-sdk-go/interactors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testAliceAddress = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	testBobAddress   = "erd1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqzu66jx"
+)
+
+func writeTestPemFile(t *testing.T, filename string, contents string) {
+	err := os.WriteFile(filename, []byte(contents), 0600)
+	require.Nil(t, err)
+}
+
+func createMockArgsDirectoryKeyring(directory string) ArgsDirectoryKeyring {
+	return ArgsDirectoryKeyring{
+		Directory:    directory,
+		Wallet:       interactors.NewWallet(),
+		KeyGenerator: messageKeyGenerator,
+	}
+}
+
+func TestNewDirectoryKeyring(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil wallet should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDirectoryKeyring(t.TempDir())
+		args.Wallet = nil
+		keyring, err := NewDirectoryKeyring(args)
+		assert.True(t, check.IfNil(keyring))
+		assert.Equal(t, ErrNilPemWallet, err)
+	})
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsDirectoryKeyring(t.TempDir())
+		args.KeyGenerator = nil
+		keyring, err := NewDirectoryKeyring(args)
+		assert.True(t, check.IfNil(keyring))
+		assert.Equal(t, ErrNilKeyGenerator, err)
+	})
+
+	t.Run("missing directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		keyring, err := NewDirectoryKeyring(createMockArgsDirectoryKeyring(filepath.Join(t.TempDir(), "missing")))
+		assert.True(t, check.IfNil(keyring))
+		assert.NotNil(t, err)
+	})
+	t.Run("file instead of directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(t.TempDir(), "alice.pem")
+		writeTestPemFile(t, filename, examples.AlicePemContents)
+
+		keyring, err := NewDirectoryKeyring(createMockArgsDirectoryKeyring(filename))
+		assert.True(t, check.IfNil(keyring))
+		assert.True(t, errors.Is(err, ErrInvalidKeyringDirectory))
+	})
+	t.Run("invalid pem file should error", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		writeTestPemFile(t, filepath.Join(directory, "invalid.pem"), "not a pem")
+
+		keyring, err := NewDirectoryKeyring(createMockArgsDirectoryKeyring(directory))
+		assert.True(t, check.IfNil(keyring))
+		assert.True(t, errors.Is(err, interactors.ErrInvalidPemFile))
+	})
+	t.Run("duplicate key should error", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		writeTestPemFile(t, filepath.Join(directory, "a.pem"), examples.AlicePemContents)
+		writeTestPemFile(t, filepath.Join(directory, "b.pem"), examples.AlicePemContents)
+
+		keyring, err := NewDirectoryKeyring(createMockArgsDirectoryKeyring(directory))
+		assert.True(t, check.IfNil(keyring))
+		assert.True(t, errors.Is(err, ErrDuplicateKey))
+	})
+	t.Run("empty directory should work", func(t *testing.T) {
+		t.Parallel()
+
+		keyring, err := NewDirectoryKeyring(createMockArgsDirectoryKeyring(t.TempDir()))
+		assert.False(t, check.IfNil(keyring))
+		assert.Nil(t, err)
+		assert.Equal(t, 0, keyring.Len())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		directory := t.TempDir()
+		writeTestPemFile(t, filepath.Join(directory, "b.pem"), examples.AlicePemContents)
+		writeTestPemFile(t, filepath.Join(directory, "a.pem"), examples.BobPemContents)
+		writeTestPemFile(t, filepath.Join(directory, "ignored.txt"), "not a pem")
+
+		keyring, err := NewDirectoryKeyring(createMockArgsDirectoryKeyring(directory))
+		require.Nil(t, err)
+		require.Equal(t, 2, keyring.Len())
+
+		holder, err := keyring.GetByIndex(0)
+		require.Nil(t, err)
+		assert.Equal(t, testBobAddress, holder.GetBech32())
+
+		holder, err = keyring.GetByAddress(testAliceAddress)
+		require.Nil(t, err)
+		assert.Equal(t, testAliceAddress, holder.GetBech32())
+
+		holders := keyring.GetAll()
+		require.Equal(t, 2, len(holders))
+		assert.Equal(t, testBobAddress, holders[0].GetBech32())
+		assert.Equal(t, testAliceAddress, holders[1].GetBech32())
+
+		_, err = keyring.GetByIndex(2)
+		assert.True(t, errors.Is(err, ErrKeyNotFound))
+		_, err = keyring.GetByAddress("erd1k2s324ww2g0yj38qn2ch2jwctdy8mnfxep94q9arncc6xecg3xaq6mjse8")
+		assert.True(t, errors.Is(err, ErrKeyNotFound))
+	})
+}
+
+func TestDirectoryKeyring_AddKey(t *testing.T) {
+	t.Parallel()
+
+	w := interactors.NewWallet()
+	aliceKey, _ := w.LoadPrivateKeyFromPemData([]byte(examples.AlicePemContents))
+	bobKey, _ := w.LoadPrivateKeyFromPemData([]byte(examples.BobPemContents))
+
+	directory := t.TempDir()
+	writeTestPemFile(t, filepath.Join(directory, "alice.pem"), examples.AlicePemContents)
+	keyring, err := NewDirectoryKeyring(createMockArgsDirectoryKeyring(directory))
+	require.Nil(t, err)
+
+	holder, err := keyring.AddKey(aliceKey)
+	assert.Nil(t, holder)
+	assert.True(t, errors.Is(err, ErrDuplicateKey))
+	assert.Equal(t, 1, keyring.Len())
+
+	holder, err = keyring.AddKey(bobKey)
+	require.Nil(t, err)
+	assert.Equal(t, testBobAddress, holder.GetBech32())
+	assert.Equal(t, 2, keyring.Len())
+	assert.FileExists(t, filepath.Join(directory, testBobAddress+".pem"))
+
+	reloadedKeyring, err := NewDirectoryKeyring(createMockArgsDirectoryKeyring(directory))
+	require.Nil(t, err)
+	assert.Equal(t, 2, reloadedKeyring.Len())
+	_, err = reloadedKeyring.GetByAddress(testBobAddress)
+	assert.Nil(t, err)
+}
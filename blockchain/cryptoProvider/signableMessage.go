@@ -0,0 +1,153 @@
+package cryptoProvider
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	// CurrentMessagePrefix is the prefix added before the messages signed by the wallets
+	CurrentMessagePrefix = "\x17MultiversX Signed Message:\n"
+	// LegacyMessagePrefix is the prefix added before the messages signed by the wallets released before the rebranding.
+	// The signatures produced with it are still accepted
+	LegacyMessagePrefix = "\x17Elrond Signed Message:\n"
+	// SignableMessageVersion is the current version of the signable message format
+	SignableMessageVersion = 1
+	// SignableMessageSigner identifies the messages signed by this SDK
+	SignableMessageSigner = "mx-sdk-go"
+
+	hexPrefix = "0x"
+)
+
+var messageKeyGenerator = signing.NewKeyGenerator(ed25519.NewEd25519())
+
+// SignableMessage is a message signed by the owner of an address, in the format used by the web wallet and the
+// browser extension
+type SignableMessage struct {
+	Address   string
+	Message   []byte
+	Signature []byte
+	Version   uint32
+	Signer    string
+}
+
+type signableMessageJSON struct {
+	Address   string `json:"address"`
+	Message   string `json:"message"`
+	Signature string `json:"signature"`
+	Version   uint32 `json:"version"`
+	Signer    string `json:"signer,omitempty"`
+}
+
+// NewSignableMessage creates a new, unsigned, message
+func NewSignableMessage(message []byte) *SignableMessage {
+	return &SignableMessage{
+		Message: message,
+		Version: SignableMessageVersion,
+		Signer:  SignableMessageSigner,
+	}
+}
+
+// SerializeForSigning returns the hash that is signed: the keccak hash of the prefix, the message length and the message
+func (msg *SignableMessage) SerializeForSigning(prefix string) []byte {
+	return serializeMessageForSigning(prefix, msg.Message)
+}
+
+// Sign signs the message using the current prefix and sets the signer's address
+func (msg *SignableMessage) Sign(cryptoHolder core.CryptoComponentsHolder) error {
+	if check.IfNil(cryptoHolder) {
+		return fmt.Errorf("%w: nil crypto components holder", ErrInvalidSignableMessage)
+	}
+
+	signature, err := singleSigner.Sign(cryptoHolder.GetPrivateKey(), msg.SerializeForSigning(CurrentMessagePrefix))
+	if err != nil {
+		return err
+	}
+
+	msg.Address = cryptoHolder.GetBech32()
+	msg.Signature = signature
+
+	return nil
+}
+
+// Verify checks that the message was signed by the owner of the message's address, using either the current or the
+// legacy prefix
+func (msg *SignableMessage) Verify() error {
+	return msg.VerifyWithAddressConverter(core.AddressPublicKeyConverter)
+}
+
+// VerifyWithAddressConverter checks the message signature as Verify does, decoding the message's address with the
+// provided address converter, for the chains that do not use the default address prefix. A nil converter means the
+// default one
+func (msg *SignableMessage) VerifyWithAddressConverter(addressConverter core.AddressConverter) error {
+	if len(msg.Signature) == 0 {
+		return fmt.Errorf("%w: missing signature", ErrInvalidSignableMessage)
+	}
+
+	address, err := data.NewAddressFromBech32StringWithConverter(msg.Address, addressConverter)
+	if err != nil {
+		return fmt.Errorf("%w: invalid address %q", ErrInvalidSignableMessage, msg.Address)
+	}
+
+	publicKey, err := messageKeyGenerator.PublicKeyFromByteArray(address.AddressBytes())
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrInvalidSignableMessage, err.Error())
+	}
+
+	err = singleSigner.Verify(publicKey, msg.SerializeForSigning(CurrentMessagePrefix), msg.Signature)
+	if err == nil {
+		return nil
+	}
+
+	err = singleSigner.Verify(publicKey, msg.SerializeForSigning(LegacyMessagePrefix), msg.Signature)
+	if err != nil {
+		return fmt.Errorf("%w for address %s", ErrInvalidMessageSignature, msg.Address)
+	}
+
+	return nil
+}
+
+// MarshalJSON returns the wallet JSON format of the message, having the message and the signature 0x-prefixed hex encoded
+func (msg *SignableMessage) MarshalJSON() ([]byte, error) {
+	return json.Marshal(&signableMessageJSON{
+		Address:   msg.Address,
+		Message:   hexPrefix + hex.EncodeToString(msg.Message),
+		Signature: hexPrefix + hex.EncodeToString(msg.Signature),
+		Version:   msg.Version,
+		Signer:    msg.Signer,
+	})
+}
+
+// UnmarshalJSON parses the wallet JSON format of the message. The 0x prefix of the hex encoded fields is optional
+func (msg *SignableMessage) UnmarshalJSON(buff []byte) error {
+	msgJSON := &signableMessageJSON{}
+	err := json.Unmarshal(buff, msgJSON)
+	if err != nil {
+		return err
+	}
+
+	message, err := hex.DecodeString(strings.TrimPrefix(msgJSON.Message, hexPrefix))
+	if err != nil {
+		return fmt.Errorf("%w: the message is not hex encoded", ErrInvalidSignableMessage)
+	}
+	signature, err := hex.DecodeString(strings.TrimPrefix(msgJSON.Signature, hexPrefix))
+	if err != nil {
+		return fmt.Errorf("%w: the signature is not hex encoded", ErrInvalidSignableMessage)
+	}
+
+	msg.Address = msgJSON.Address
+	msg.Message = message
+	msg.Signature = signature
+	msg.Version = msgJSON.Version
+	msg.Signer = msgJSON.Signer
+
+	return nil
+}
@@ -0,0 +1,164 @@
+package cryptoProvider
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createSignableMessageHolder(t *testing.T) *cryptoComponentsHolder {
+	sk, _ := hex.DecodeString("45f72e8b6e8d10086bacd2fc8fa1340f82a3f5d4ef31953b463ea03c606533a6")
+	holder, err := NewCryptoComponentsHolder(keyGen, sk)
+	require.Nil(t, err)
+
+	return holder
+}
+
+func TestSignableMessage_SignAndVerify(t *testing.T) {
+	t.Parallel()
+
+	holder := createSignableMessageHolder(t)
+
+	t.Run("nil crypto holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		msg := NewSignableMessage([]byte("hello"))
+		err := msg.Sign(nil)
+		assert.True(t, errors.Is(err, ErrInvalidSignableMessage))
+	})
+	t.Run("missing signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		msg := NewSignableMessage([]byte("hello"))
+		msg.Address = holder.GetBech32()
+		err := msg.Verify()
+		assert.True(t, errors.Is(err, ErrInvalidSignableMessage))
+	})
+	t.Run("invalid address should error", func(t *testing.T) {
+		t.Parallel()
+
+		msg := NewSignableMessage([]byte("hello"))
+		require.Nil(t, msg.Sign(holder))
+		msg.Address = "erd1invalid"
+		err := msg.Verify()
+		assert.True(t, errors.Is(err, ErrInvalidSignableMessage))
+	})
+	t.Run("altered message should error", func(t *testing.T) {
+		t.Parallel()
+
+		msg := NewSignableMessage([]byte("hello"))
+		require.Nil(t, msg.Sign(holder))
+		msg.Message = []byte("hello!")
+		err := msg.Verify()
+		assert.True(t, errors.Is(err, ErrInvalidMessageSignature))
+	})
+	t.Run("other address should error", func(t *testing.T) {
+		t.Parallel()
+
+		msg := NewSignableMessage([]byte("hello"))
+		require.Nil(t, msg.Sign(holder))
+		msg.Address = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+		err := msg.Verify()
+		assert.True(t, errors.Is(err, ErrInvalidMessageSignature))
+	})
+	t.Run("current prefix should work", func(t *testing.T) {
+		t.Parallel()
+
+		msg := NewSignableMessage([]byte("hello"))
+		require.Nil(t, msg.Sign(holder))
+		assert.Equal(t, holder.GetBech32(), msg.Address)
+		assert.Nil(t, msg.Verify())
+
+		err := NewSigner().VerifyByteSlice(msg.SerializeForSigning(CurrentMessagePrefix), holder.GetPublicKey(), msg.Signature)
+		assert.Nil(t, err)
+	})
+	t.Run("legacy prefix should work", func(t *testing.T) {
+		t.Parallel()
+
+		signature, err := NewSigner().SignMessage([]byte("hello"), holder.GetPrivateKey())
+		require.Nil(t, err)
+
+		msg := &SignableMessage{
+			Address:   holder.GetBech32(),
+			Message:   []byte("hello"),
+			Signature: signature,
+			Version:   SignableMessageVersion,
+		}
+		assert.Nil(t, msg.Verify())
+	})
+}
+
+func TestSignableMessage_VerifyWithAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	converter, err := core.NewAddressPublicKeyConverter("sov")
+	require.Nil(t, err)
+	sk, _ := hex.DecodeString("45f72e8b6e8d10086bacd2fc8fa1340f82a3f5d4ef31953b463ea03c606533a6")
+	holder, err := NewCryptoComponentsHolderWithAddressConverter(keyGen, sk, converter)
+	require.Nil(t, err)
+
+	msg := NewSignableMessage([]byte("hello"))
+	require.Nil(t, msg.Sign(holder))
+	assert.Equal(t, holder.GetBech32(), msg.Address)
+
+	err = msg.Verify()
+	assert.True(t, errors.Is(err, ErrInvalidSignableMessage))
+
+	assert.Nil(t, msg.VerifyWithAddressConverter(converter))
+}
+
+func TestSignableMessage_JSON(t *testing.T) {
+	t.Parallel()
+
+	t.Run("wallet format should work", func(t *testing.T) {
+		t.Parallel()
+
+		msg := NewSignableMessage([]byte("hello"))
+		require.Nil(t, msg.Sign(createSignableMessageHolder(t)))
+
+		buff, err := json.Marshal(msg)
+		require.Nil(t, err)
+
+		fields := make(map[string]interface{})
+		require.Nil(t, json.Unmarshal(buff, &fields))
+		assert.Equal(t, msg.Address, fields["address"])
+		assert.Equal(t, "0x68656c6c6f", fields["message"])
+		assert.Equal(t, "0x"+hex.EncodeToString(msg.Signature), fields["signature"])
+		assert.Equal(t, float64(SignableMessageVersion), fields["version"])
+		assert.Equal(t, SignableMessageSigner, fields["signer"])
+
+		decoded := &SignableMessage{}
+		require.Nil(t, json.Unmarshal(buff, decoded))
+		assert.Equal(t, msg, decoded)
+		assert.Nil(t, decoded.Verify())
+	})
+	t.Run("missing 0x prefix should work", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := &SignableMessage{}
+		err := json.Unmarshal([]byte(`{"address":"erd1","message":"68656c6c6f","signature":"aabb","version":1}`), decoded)
+		require.Nil(t, err)
+		assert.Equal(t, []byte("hello"), decoded.Message)
+		assert.Equal(t, []byte{0xaa, 0xbb}, decoded.Signature)
+		assert.Empty(t, decoded.Signer)
+	})
+	t.Run("not hex encoded message should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := &SignableMessage{}
+		err := json.Unmarshal([]byte(`{"address":"erd1","message":"hello","signature":"0xaabb","version":1}`), decoded)
+		assert.True(t, errors.Is(err, ErrInvalidSignableMessage))
+	})
+	t.Run("not hex encoded signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		decoded := &SignableMessage{}
+		err := json.Unmarshal([]byte(`{"address":"erd1","message":"0x68656c6c6f","signature":"0xzz","version":1}`), decoded)
+		assert.True(t, errors.Is(err, ErrInvalidSignableMessage))
+	})
+}
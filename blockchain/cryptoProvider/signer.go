@@ -12,10 +12,9 @@ import (
 )
 
 var (
-	signerLog     = logger.GetOrCreate("mx-sdk-go/signer")
-	hasher        = keccak.NewKeccak()
-	singleSigner  = &singlesig.Ed25519Signer{}
-	messagePrefix = []byte("\x17Elrond Signed Message:\n")
+	signerLog    = logger.GetOrCreate("mx-sdk-go/signer")
+	hasher       = keccak.NewKeccak()
+	singleSigner = &singlesig.Ed25519Signer{}
 )
 
 // signer contains the primitives used to correctly sign a transaction
@@ -72,11 +71,17 @@ func (s *signer) SignTransaction(tx *transaction.FrontendTransaction, privateKey
 }
 
 func (s *signer) serializeForSigning(msg []byte) []byte {
+	return serializeMessageForSigning(LegacyMessagePrefix, msg)
+}
+
+func serializeMessageForSigning(prefix string, msg []byte) []byte {
 	msgSize := strconv.FormatInt(int64(len(msg)), 10)
-	msg = append([]byte(msgSize), msg...)
-	msg = append(messagePrefix, msg...)
+	serializedMessage := make([]byte, 0, len(prefix)+len(msgSize)+len(msg))
+	serializedMessage = append(serializedMessage, prefix...)
+	serializedMessage = append(serializedMessage, msgSize...)
+	serializedMessage = append(serializedMessage, msg...)
 
-	return hasher.Compute(string(msg))
+	return hasher.Compute(string(serializedMessage))
 }
 
 // IsInterfaceNil returns true if there is no value under the interface
@@ -0,0 +1,224 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	contractConfigNumResults = 10
+	blsKeyLength             = 96
+)
+
+type delegationQueryGetter struct {
+	queryGetter      VMQueryGetter
+	addressConverter core.AddressConverter
+}
+
+// NewDelegationQueryGetter creates a new instance able to execute the typed delegation contract queries
+func NewDelegationQueryGetter(queryGetter VMQueryGetter) (*delegationQueryGetter, error) {
+	return NewDelegationQueryGetterWithAddressConverter(queryGetter, core.AddressPublicKeyConverter)
+}
+
+// NewDelegationQueryGetterWithAddressConverter creates a new delegation query getter that encodes the returned addresses
+// using the provided address converter, for the chains that do not use the default address prefix. A nil converter
+// means the default one
+func NewDelegationQueryGetterWithAddressConverter(
+	queryGetter VMQueryGetter,
+	addressConverter core.AddressConverter,
+) (*delegationQueryGetter, error) {
+	if check.IfNil(queryGetter) {
+		return nil, ErrNilVMQueryGetter
+	}
+
+	return &delegationQueryGetter{
+		queryGetter:      queryGetter,
+		addressConverter: core.AddressConverterOrDefault(addressConverter),
+	}, nil
+}
+
+// GetTotalActiveStake returns the total active stake of the delegation contract
+func (getter *delegationQueryGetter) GetTotalActiveStake(ctx context.Context, delegationContract core.AddressHandler) (*big.Int, error) {
+	builder := builders.NewVMQueryBuilder().
+		Address(delegationContract).
+		Function("getTotalActiveStake")
+
+	return getter.executeQueryReturningBigInt(ctx, builder)
+}
+
+// GetUserActiveStake returns the active stake of the user in the delegation contract
+func (getter *delegationQueryGetter) GetUserActiveStake(
+	ctx context.Context,
+	delegationContract core.AddressHandler,
+	user core.AddressHandler,
+) (*big.Int, error) {
+	builder := builders.NewVMQueryBuilder().
+		Address(delegationContract).
+		Function("getUserActiveStake").
+		ArgAddress(user)
+
+	return getter.executeQueryReturningBigInt(ctx, builder)
+}
+
+// GetClaimableRewards returns the rewards the user can claim from the delegation contract
+func (getter *delegationQueryGetter) GetClaimableRewards(
+	ctx context.Context,
+	delegationContract core.AddressHandler,
+	user core.AddressHandler,
+) (*big.Int, error) {
+	builder := builders.NewVMQueryBuilder().
+		Address(delegationContract).
+		Function("getClaimableRewards").
+		ArgAddress(user)
+
+	return getter.executeQueryReturningBigInt(ctx, builder)
+}
+
+// GetUserUnDelegatedList returns the user's un-delegated funds, each with the number of epochs left until it can be withdrawn
+func (getter *delegationQueryGetter) GetUserUnDelegatedList(
+	ctx context.Context,
+	delegationContract core.AddressHandler,
+	user core.AddressHandler,
+) ([]*data.UnDelegatedFund, error) {
+	builder := builders.NewVMQueryBuilder().
+		Address(delegationContract).
+		Function("getUserUnDelegatedList").
+		ArgAddress(user)
+
+	response, err := getter.queryGetter.ExecuteQueryFromBuilder(ctx, builder)
+	if err != nil {
+		return nil, err
+	}
+	if len(response)%2 != 0 {
+		return nil, fmt.Errorf("%w, odd number of results for getUserUnDelegatedList: %d", ErrInvalidVMQueryResponse, len(response))
+	}
+
+	funds := make([]*data.UnDelegatedFund, 0, len(response)/2)
+	for i := 0; i < len(response); i += 2 {
+		remainingEpochs, errParse := parseUInt64FromByteSlice(response[i+1])
+		if errParse != nil {
+			return nil, errParse
+		}
+
+		funds = append(funds, &data.UnDelegatedFund{
+			Amount:          big.NewInt(0).SetBytes(response[i]),
+			RemainingEpochs: remainingEpochs,
+		})
+	}
+
+	return funds, nil
+}
+
+// GetContractConfig returns the delegation contract configuration
+func (getter *delegationQueryGetter) GetContractConfig(
+	ctx context.Context,
+	delegationContract core.AddressHandler,
+) (*data.DelegationContractConfig, error) {
+	builder := builders.NewVMQueryBuilder().
+		Address(delegationContract).
+		Function("getContractConfig")
+
+	response, err := getter.queryGetter.ExecuteQueryFromBuilder(ctx, builder)
+	if err != nil {
+		return nil, err
+	}
+	if len(response) != contractConfigNumResults {
+		return nil, fmt.Errorf("%w, expected %d results for getContractConfig, got %d",
+			ErrInvalidVMQueryResponse, contractConfigNumResults, len(response))
+	}
+
+	ownerAddress, err := data.NewAddressFromBytesWithConverter(response[0], getter.addressConverter).AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	uint64Values := make([]uint64, 0, 3)
+	for _, index := range []int{1, 8, 9} {
+		value, errParse := parseUInt64FromByteSlice(response[index])
+		if errParse != nil {
+			return nil, errParse
+		}
+		uint64Values = append(uint64Values, value)
+	}
+
+	boolValues := make([]bool, 0, 4)
+	for index := 4; index < 8; index++ {
+		value, errParse := strconv.ParseBool(string(response[index]))
+		if errParse != nil {
+			return nil, fmt.Errorf("%w, %s", ErrInvalidVMQueryResponse, errParse.Error())
+		}
+		boolValues = append(boolValues, value)
+	}
+
+	return &data.DelegationContractConfig{
+		OwnerAddress:         ownerAddress,
+		ServiceFee:           uint64Values[0],
+		MaxDelegationCap:     big.NewInt(0).SetBytes(response[2]),
+		InitialOwnerFunds:    big.NewInt(0).SetBytes(response[3]),
+		AutomaticActivation:  boolValues[0],
+		WithDelegationCap:    boolValues[1],
+		ChangeableServiceFee: boolValues[2],
+		CheckCapOnReDelegate: boolValues[3],
+		CreatedNonce:         uint64Values[1],
+		UnBondPeriod:         uint64Values[2],
+	}, nil
+}
+
+// GetAllNodeStates returns the states of all nodes added on the delegation contract. The query response contains
+// a state name followed by the BLS keys having that state
+func (getter *delegationQueryGetter) GetAllNodeStates(
+	ctx context.Context,
+	delegationContract core.AddressHandler,
+) ([]*data.NodeState, error) {
+	builder := builders.NewVMQueryBuilder().
+		Address(delegationContract).
+		Function("getAllNodeStates")
+
+	response, err := getter.queryGetter.ExecuteQueryFromBuilder(ctx, builder)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeStates := make([]*data.NodeState, 0, len(response))
+	currentState := ""
+	for _, item := range response {
+		if len(item) != blsKeyLength {
+			currentState = string(item)
+			continue
+		}
+		if len(currentState) == 0 {
+			return nil, fmt.Errorf("%w, BLS key without state in getAllNodeStates", ErrInvalidVMQueryResponse)
+		}
+
+		nodeStates = append(nodeStates, &data.NodeState{
+			BLSKey: item,
+			State:  currentState,
+		})
+	}
+
+	return nodeStates, nil
+}
+
+func (getter *delegationQueryGetter) executeQueryReturningBigInt(ctx context.Context, builder builders.VMQueryBuilder) (*big.Int, error) {
+	response, err := getter.queryGetter.ExecuteQueryFromBuilder(ctx, builder)
+	if err != nil {
+		return nil, err
+	}
+	if len(response) == 0 {
+		return big.NewInt(0), nil
+	}
+
+	return big.NewInt(0).SetBytes(response[0]), nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (getter *delegationQueryGetter) IsInterfaceNil() bool {
+	return getter == nil
+}
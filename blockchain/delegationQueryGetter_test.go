@@ -0,0 +1,238 @@
+package blockchain
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testDelegationContract = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqylllslmq6y6"
+
+func createDelegationQueryGetter(t *testing.T, proxy Proxy) *delegationQueryGetter {
+	queryGetter, err := NewVmQueryGetter(ArgsVmQueryGetter{
+		Proxy: proxy,
+		Log:   logger.GetOrCreate("test"),
+	})
+	require.Nil(t, err)
+
+	getter, err := NewDelegationQueryGetter(queryGetter)
+	require.Nil(t, err)
+
+	return getter
+}
+
+func TestNewDelegationQueryGetter(t *testing.T) {
+	t.Parallel()
+
+	getter, err := NewDelegationQueryGetter(nil)
+	assert.Equal(t, ErrNilVMQueryGetter, err)
+	assert.True(t, check.IfNil(getter))
+
+	getter = createDelegationQueryGetter(t, &testsCommon.ProxyStub{})
+	assert.False(t, check.IfNil(getter))
+}
+
+func TestDelegationQueryGetter_BigIntQueries(t *testing.T) {
+	t.Parallel()
+
+	contract, _ := data.NewAddressFromBech32String(testDelegationContract)
+	user, _ := data.NewAddressFromBech32String(testSCAddressBech32)
+	expectedValue := big.NewInt(1234567)
+
+	t.Run("query errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		getter := createDelegationQueryGetter(t, &testsCommon.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				return nil, expectedErr
+			},
+		})
+
+		value, err := getter.GetTotalActiveStake(context.Background(), contract)
+		assert.Nil(t, value)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		calledFunctions := make([]string, 0)
+		getter := createDelegationQueryGetter(t, &testsCommon.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				assert.Equal(t, testDelegationContract, vmRequest.Address)
+				calledFunctions = append(calledFunctions, vmRequest.FuncName)
+
+				return &data.VmValuesResponseData{
+					Data: &vm.VMOutputApi{
+						ReturnCode: okCodeAfterExecution,
+						ReturnData: [][]byte{expectedValue.Bytes()},
+					},
+				}, nil
+			},
+		})
+
+		value, err := getter.GetTotalActiveStake(context.Background(), contract)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedValue, value)
+
+		value, err = getter.GetUserActiveStake(context.Background(), contract, user)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedValue, value)
+
+		value, err = getter.GetClaimableRewards(context.Background(), contract, user)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedValue, value)
+
+		assert.Equal(t, []string{"getTotalActiveStake", "getUserActiveStake", "getClaimableRewards"}, calledFunctions)
+	})
+}
+
+func TestDelegationQueryGetter_GetUserUnDelegatedList(t *testing.T) {
+	t.Parallel()
+
+	contract, _ := data.NewAddressFromBech32String(testDelegationContract)
+	user, _ := data.NewAddressFromBech32String(testSCAddressBech32)
+
+	t.Run("odd number of results should error", func(t *testing.T) {
+		t.Parallel()
+
+		getter := createDelegationQueryGetter(t, createMockProxy([][]byte{{1}}))
+		funds, err := getter.GetUserUnDelegatedList(context.Background(), contract, user)
+		assert.Nil(t, funds)
+		assert.ErrorIs(t, err, ErrInvalidVMQueryResponse)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		getter := createDelegationQueryGetter(t, createMockProxy([][]byte{{10}, {2}, {20}, {}}))
+		funds, err := getter.GetUserUnDelegatedList(context.Background(), contract, user)
+		assert.Nil(t, err)
+		expectedFunds := []*data.UnDelegatedFund{
+			{Amount: big.NewInt(10), RemainingEpochs: 2},
+			{Amount: big.NewInt(20), RemainingEpochs: 0},
+		}
+		assert.Equal(t, expectedFunds, funds)
+	})
+}
+
+func TestDelegationQueryGetter_GetContractConfig(t *testing.T) {
+	t.Parallel()
+
+	contract, _ := data.NewAddressFromBech32String(testDelegationContract)
+	owner, _ := data.NewAddressFromBech32String(testSCAddressBech32)
+
+	t.Run("invalid number of results should error", func(t *testing.T) {
+		t.Parallel()
+
+		getter := createDelegationQueryGetter(t, createMockProxy([][]byte{owner.AddressBytes()}))
+		config, err := getter.GetContractConfig(context.Background(), contract)
+		assert.Nil(t, config)
+		assert.ErrorIs(t, err, ErrInvalidVMQueryResponse)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		getter := createDelegationQueryGetter(t, createMockProxy([][]byte{
+			owner.AddressBytes(),
+			big.NewInt(1000).Bytes(),
+			big.NewInt(5000).Bytes(),
+			big.NewInt(1250).Bytes(),
+			[]byte("false"),
+			[]byte("true"),
+			[]byte("true"),
+			[]byte("false"),
+			big.NewInt(42).Bytes(),
+			big.NewInt(10).Bytes(),
+		}))
+		config, err := getter.GetContractConfig(context.Background(), contract)
+		assert.Nil(t, err)
+		expectedConfig := &data.DelegationContractConfig{
+			OwnerAddress:         testSCAddressBech32,
+			ServiceFee:           1000,
+			MaxDelegationCap:     big.NewInt(5000),
+			InitialOwnerFunds:    big.NewInt(1250),
+			AutomaticActivation:  false,
+			WithDelegationCap:    true,
+			ChangeableServiceFee: true,
+			CheckCapOnReDelegate: false,
+			CreatedNonce:         42,
+			UnBondPeriod:         10,
+		}
+		assert.Equal(t, expectedConfig, config)
+	})
+}
+
+func TestDelegationQueryGetter_WithAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	converter, err := core.NewAddressPublicKeyConverter("sov")
+	require.Nil(t, err)
+
+	getter, err := NewDelegationQueryGetterWithAddressConverter(nil, converter)
+	assert.Equal(t, ErrNilVMQueryGetter, err)
+	assert.True(t, check.IfNil(getter))
+
+	contract, _ := data.NewAddressFromBech32String(testDelegationContract)
+	owner, _ := data.NewAddressFromBech32String(testSCAddressBech32)
+	expectedOwner, _ := converter.Encode(owner.AddressBytes())
+
+	queryGetter, err := NewVmQueryGetter(ArgsVmQueryGetter{
+		Proxy: createMockProxy([][]byte{
+			owner.AddressBytes(), {}, {}, {}, []byte("false"), []byte("true"), []byte("true"), []byte("false"), {}, {},
+		}),
+		Log: logger.GetOrCreate("test"),
+	})
+	require.Nil(t, err)
+
+	getter, err = NewDelegationQueryGetterWithAddressConverter(queryGetter, converter)
+	require.Nil(t, err)
+
+	config, err := getter.GetContractConfig(context.Background(), contract)
+	require.Nil(t, err)
+	assert.Equal(t, expectedOwner, config.OwnerAddress)
+}
+
+func TestDelegationQueryGetter_GetAllNodeStates(t *testing.T) {
+	t.Parallel()
+
+	contract, _ := data.NewAddressFromBech32String(testDelegationContract)
+	key1 := bytes.Repeat([]byte{1}, blsKeyLength)
+	key2 := bytes.Repeat([]byte{2}, blsKeyLength)
+	key3 := bytes.Repeat([]byte{3}, blsKeyLength)
+
+	t.Run("key without state should error", func(t *testing.T) {
+		t.Parallel()
+
+		getter := createDelegationQueryGetter(t, createMockProxy([][]byte{key1}))
+		states, err := getter.GetAllNodeStates(context.Background(), contract)
+		assert.Nil(t, states)
+		assert.ErrorIs(t, err, ErrInvalidVMQueryResponse)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		getter := createDelegationQueryGetter(t, createMockProxy([][]byte{
+			[]byte("staked"), key1, key2,
+			[]byte("notStaked"), key3,
+		}))
+		states, err := getter.GetAllNodeStates(context.Background(), contract)
+		assert.Nil(t, err)
+		expectedStates := []*data.NodeState{
+			{BLSKey: key1, State: "staked"},
+			{BLSKey: key2, State: "staked"},
+			{BLSKey: key3, State: "notStaked"},
+		}
+		assert.Equal(t, expectedStates, states)
+	})
+}
@@ -63,6 +63,12 @@ var ErrInvalidBlockRange = errors.New("invalid block range: too many blocks to p
 // ErrNoBlockRangeProvided signals that no block range was provided
 var ErrNoBlockRangeProvided = errors.New("no block range specified")
 
+// ErrNilVMQueryGetter signals that a nil VM query getter has been provided
+var ErrNilVMQueryGetter = errors.New("nil VM query getter")
+
+// ErrInvalidVMQueryResponse signals that the VM query response does not have the expected format
+var ErrInvalidVMQueryResponse = errors.New("invalid VM query response")
+
 func createHTTPStatusError(httpStatusCode int, err error) error {
 	if err == nil {
 		err = ErrHTTPStatusCodeIsNotOK
@@ -71,3 +77,18 @@ func createHTTPStatusError(httpStatusCode int, err error) error {
 	return fmt.Errorf("%w, returned http status: %d, %s",
 		err, httpStatusCode, http.StatusText(httpStatusCode))
 }
+
+// ErrNilDNSAddressGenerator signals that a nil DNS address generator has been provided
+var ErrNilDNSAddressGenerator = errors.New("nil DNS address generator")
+
+// ErrUsernameNotRegistered signals that the username is not registered
+var ErrUsernameNotRegistered = errors.New("username not registered")
+
+// ErrUsernameNotAvailable signals that the username is already registered
+var ErrUsernameNotAvailable = errors.New("username not available")
+
+// ErrNoUsernameForAddress signals that the account does not have a username
+var ErrNoUsernameForAddress = errors.New("no username for address")
+
+// ErrNilAddressConverter signals that a nil address converter was provided
+var ErrNilAddressConverter = errors.New("nil address converter")
@@ -0,0 +1,282 @@
+package blockchain
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strconv"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/serde"
+)
+
+const uint64Size = 8
+
+// rawGovernanceConfig mirrors the viewConfig results, all returned as strings
+type rawGovernanceConfig struct {
+	ProposalFee       string
+	LostProposalFee   string
+	MinQuorum         string
+	MinPassThreshold  string
+	MinVetoThreshold  string
+	LastProposalNonce string
+}
+
+// rawGovernanceProposal mirrors the viewProposal results
+type rawGovernanceProposal struct {
+	Cost           big.Int
+	CommitHash     string
+	Nonce          uint64
+	IssuerAddress  string
+	StartVoteEpoch uint64
+	EndVoteEpoch   uint64
+	QuorumStake    big.Int
+	Yes            big.Int
+	No             big.Int
+	Veto           big.Int
+	Abstain        big.Int
+	Closed         string
+	Passed         string
+}
+
+type governanceQueryGetter struct {
+	queryGetter      VMQueryGetter
+	deserializer     serde.Deserializer
+	addressConverter core.AddressConverter
+	scAddress        core.AddressHandler
+}
+
+// NewGovernanceQueryGetter creates a new instance able to execute the typed governance system smart contract queries
+func NewGovernanceQueryGetter(queryGetter VMQueryGetter) (*governanceQueryGetter, error) {
+	return NewGovernanceQueryGetterWithAddressConverter(queryGetter, core.AddressPublicKeyConverter)
+}
+
+// NewGovernanceQueryGetterWithAddressConverter creates a new governance query getter that encodes the governance system
+// smart contract address and the returned addresses using the provided address converter, for the chains that do not
+// use the default address prefix. A nil converter means the default one
+func NewGovernanceQueryGetterWithAddressConverter(
+	queryGetter VMQueryGetter,
+	addressConverter core.AddressConverter,
+) (*governanceQueryGetter, error) {
+	if check.IfNil(queryGetter) {
+		return nil, ErrNilVMQueryGetter
+	}
+
+	scAddressBytes, err := core.AddressPublicKeyConverter.Decode(builders.GovernanceSCAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	addressConverter = core.AddressConverterOrDefault(addressConverter)
+
+	return &governanceQueryGetter{
+		queryGetter:      queryGetter,
+		deserializer:     serde.NewDeserializer(),
+		addressConverter: addressConverter,
+		scAddress:        data.NewAddressFromBytesWithConverter(scAddressBytes, addressConverter),
+	}, nil
+}
+
+// GetConfig returns the governance configuration, including the proposal fee
+func (getter *governanceQueryGetter) GetConfig(ctx context.Context) (*data.GovernanceConfig, error) {
+	builder := builders.NewVMQueryBuilder().
+		Address(getter.scAddress).
+		Function("viewConfig")
+
+	raw := &rawGovernanceConfig{}
+	err := getter.executeQueryIntoStruct(ctx, builder, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	proposalFee, err := parseDecimalBigInt(raw.ProposalFee)
+	if err != nil {
+		return nil, err
+	}
+	lostProposalFee, err := parseDecimalBigInt(raw.LostProposalFee)
+	if err != nil {
+		return nil, err
+	}
+
+	floatValues := make([]float64, 0, 3)
+	for _, value := range []string{raw.MinQuorum, raw.MinPassThreshold, raw.MinVetoThreshold} {
+		floatValue, errParse := strconv.ParseFloat(value, 64)
+		if errParse != nil {
+			return nil, fmt.Errorf("%w, %s", ErrInvalidVMQueryResponse, errParse.Error())
+		}
+		floatValues = append(floatValues, floatValue)
+	}
+
+	lastProposalNonce, err := strconv.ParseUint(raw.LastProposalNonce, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %s", ErrInvalidVMQueryResponse, err.Error())
+	}
+
+	return &data.GovernanceConfig{
+		ProposalFee:       proposalFee,
+		LostProposalFee:   lostProposalFee,
+		MinQuorum:         floatValues[0],
+		MinPassThreshold:  floatValues[1],
+		MinVetoThreshold:  floatValues[2],
+		LastProposalNonce: lastProposalNonce,
+	}, nil
+}
+
+// GetProposal returns the governance proposal with the provided nonce
+func (getter *governanceQueryGetter) GetProposal(ctx context.Context, proposalNonce uint64) (*data.GovernanceProposal, error) {
+	builder := builders.NewVMQueryBuilder().
+		Address(getter.scAddress).
+		Function("viewProposal").
+		ArgBigInt(big.NewInt(0).SetUint64(proposalNonce))
+
+	raw := &rawGovernanceProposal{}
+	err := getter.executeQueryIntoStruct(ctx, builder, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	issuerAddress, err := data.NewAddressFromBytesWithConverter([]byte(raw.IssuerAddress), getter.addressConverter).AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+	closed, err := strconv.ParseBool(raw.Closed)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %s", ErrInvalidVMQueryResponse, err.Error())
+	}
+	passed, err := strconv.ParseBool(raw.Passed)
+	if err != nil {
+		return nil, fmt.Errorf("%w, %s", ErrInvalidVMQueryResponse, err.Error())
+	}
+
+	return &data.GovernanceProposal{
+		Cost:           &raw.Cost,
+		CommitHash:     raw.CommitHash,
+		Nonce:          raw.Nonce,
+		IssuerAddress:  issuerAddress,
+		StartVoteEpoch: raw.StartVoteEpoch,
+		EndVoteEpoch:   raw.EndVoteEpoch,
+		QuorumStake:    &raw.QuorumStake,
+		Yes:            &raw.Yes,
+		No:             &raw.No,
+		Veto:           &raw.Veto,
+		Abstain:        &raw.Abstain,
+		Closed:         closed,
+		Passed:         passed,
+	}, nil
+}
+
+// GetUserVoteHistory returns the nonces of the proposals voted by the user. The query returns the direct votes
+// followed by the delegated votes, each as a list of u64 nonces
+func (getter *governanceQueryGetter) GetUserVoteHistory(ctx context.Context, user core.AddressHandler) (*data.UserVoteHistory, error) {
+	builder := builders.NewVMQueryBuilder().
+		Address(getter.scAddress).
+		Function("viewUserVoteHistory").
+		ArgAddress(user)
+
+	response, err := getter.queryGetter.ExecuteQueryFromBuilder(ctx, builder)
+	if err != nil {
+		return nil, err
+	}
+
+	history := &data.UserVoteHistory{
+		DirectVotes:    make([]uint64, 0),
+		DelegatedVotes: make([]uint64, 0),
+	}
+	if len(response) > 0 {
+		history.DirectVotes, err = getter.decodeUint64List(response[0])
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(response) > 1 {
+		history.DelegatedVotes, err = getter.decodeUint64List(response[1])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return history, nil
+}
+
+func (getter *governanceQueryGetter) executeQueryIntoStruct(ctx context.Context, builder builders.VMQueryBuilder, obj interface{}) error {
+	response, err := getter.queryGetter.ExecuteQueryFromBuilder(ctx, builder)
+	if err != nil {
+		return err
+	}
+
+	return getter.decodeReturnData(obj, response)
+}
+
+// decodeReturnData decodes each top-level return data item into the matching field of the provided struct
+func (getter *governanceQueryGetter) decodeReturnData(obj interface{}, returnData [][]byte) error {
+	value := reflect.ValueOf(obj).Elem()
+	if value.NumField() != len(returnData) {
+		return fmt.Errorf("%w, expected %d results, got %d", ErrInvalidVMQueryResponse, value.NumField(), len(returnData))
+	}
+
+	for i := 0; i < value.NumField(); i++ {
+		buff := returnData[i]
+		if value.Field(i).Kind() == reflect.Uint64 {
+			var err error
+			buff, err = padUint64Bytes(buff)
+			if err != nil {
+				return err
+			}
+		}
+
+		err := getter.deserializer.CreatePrimitiveDataType(value.Field(i), buff)
+		if err != nil {
+			return fmt.Errorf("%w, field %s: %s", ErrInvalidVMQueryResponse, value.Type().Field(i).Name, err.Error())
+		}
+	}
+
+	return nil
+}
+
+func (getter *governanceQueryGetter) decodeUint64List(buff []byte) ([]uint64, error) {
+	if len(buff)%uint64Size != 0 {
+		return nil, fmt.Errorf("%w, invalid u64 list length %d", ErrInvalidVMQueryResponse, len(buff))
+	}
+
+	values := make([]uint64, 0, len(buff)/uint64Size)
+	for i := 0; i < len(buff); i += uint64Size {
+		var value uint64
+		err := getter.deserializer.CreatePrimitiveDataType(&value, buff[i:i+uint64Size])
+		if err != nil {
+			return nil, err
+		}
+		values = append(values, value)
+	}
+
+	return values, nil
+}
+
+// padUint64Bytes converts the top-level (minimal) encoding of an u64 into its 8 bytes fixed size encoding
+func padUint64Bytes(buff []byte) ([]byte, error) {
+	if len(buff) > uint64Size {
+		return nil, fmt.Errorf("%w, %s", ErrInvalidVMQueryResponse, ErrNotUint64Bytes.Error())
+	}
+
+	padded := make([]byte, uint64Size)
+	copy(padded[uint64Size-len(buff):], buff)
+
+	return padded, nil
+}
+
+func parseDecimalBigInt(value string) (*big.Int, error) {
+	result, ok := big.NewInt(0).SetString(value, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w, invalid number %s", ErrInvalidVMQueryResponse, value)
+	}
+
+	return result, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (getter *governanceQueryGetter) IsInterfaceNil() bool {
+	return getter == nil
+}
@@ -0,0 +1,194 @@
+package blockchain
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createGovernanceQueryGetter(t *testing.T, proxy Proxy) *governanceQueryGetter {
+	queryGetter, err := NewVmQueryGetter(ArgsVmQueryGetter{
+		Proxy: proxy,
+		Log:   logger.GetOrCreate("test"),
+	})
+	require.Nil(t, err)
+
+	getter, err := NewGovernanceQueryGetter(queryGetter)
+	require.Nil(t, err)
+
+	return getter
+}
+
+func TestNewGovernanceQueryGetter(t *testing.T) {
+	t.Parallel()
+
+	getter, err := NewGovernanceQueryGetter(nil)
+	assert.Equal(t, ErrNilVMQueryGetter, err)
+	assert.True(t, check.IfNil(getter))
+
+	getter = createGovernanceQueryGetter(t, &testsCommon.ProxyStub{})
+	assert.False(t, check.IfNil(getter))
+}
+
+func TestGovernanceQueryGetter_GetConfig(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of results should error", func(t *testing.T) {
+		t.Parallel()
+
+		getter := createGovernanceQueryGetter(t, createMockProxy([][]byte{[]byte("1000")}))
+		config, err := getter.GetConfig(context.Background())
+		assert.Nil(t, config)
+		assert.ErrorIs(t, err, ErrInvalidVMQueryResponse)
+	})
+	t.Run("invalid proposal fee should error", func(t *testing.T) {
+		t.Parallel()
+
+		getter := createGovernanceQueryGetter(t, createMockProxy([][]byte{
+			[]byte("not a number"), []byte("10"), []byte("0.2"), []byte("0.5"), []byte("0.33"), []byte("4"),
+		}))
+		config, err := getter.GetConfig(context.Background())
+		assert.Nil(t, config)
+		assert.ErrorIs(t, err, ErrInvalidVMQueryResponse)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		getter := createGovernanceQueryGetter(t, createMockProxy([][]byte{
+			[]byte("1000000000000000000000"), []byte("10"), []byte("0.2"), []byte("0.5"), []byte("0.33"), []byte("4"),
+		}))
+		config, err := getter.GetConfig(context.Background())
+		require.Nil(t, err)
+
+		expectedFee, _ := big.NewInt(0).SetString("1000000000000000000000", 10)
+		expectedConfig := &data.GovernanceConfig{
+			ProposalFee:       expectedFee,
+			LostProposalFee:   big.NewInt(10),
+			MinQuorum:         0.2,
+			MinPassThreshold:  0.5,
+			MinVetoThreshold:  0.33,
+			LastProposalNonce: 4,
+		}
+		assert.Equal(t, expectedConfig, config)
+	})
+}
+
+func TestGovernanceQueryGetter_GetProposal(t *testing.T) {
+	t.Parallel()
+
+	issuer, _ := data.NewAddressFromBech32String(testSCAddressBech32)
+
+	t.Run("invalid nonce should error", func(t *testing.T) {
+		t.Parallel()
+
+		getter := createGovernanceQueryGetter(t, createMockProxy([][]byte{
+			{100}, []byte("hash"), make([]byte, 9), issuer.AddressBytes(), {10}, {20},
+			{}, {3}, {}, {}, {1}, []byte("false"), []byte("false"),
+		}))
+		proposal, err := getter.GetProposal(context.Background(), 1)
+		assert.Nil(t, proposal)
+		assert.ErrorIs(t, err, ErrInvalidVMQueryResponse)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		getter := createGovernanceQueryGetter(t, createMockProxy([][]byte{
+			{100}, []byte("1db734c0315f9ec422b88f679ccfe3e0197b9d67"), {1}, issuer.AddressBytes(), {10}, {20},
+			{5}, {3}, {}, {}, {1}, []byte("true"), []byte("false"),
+		}))
+		proposal, err := getter.GetProposal(context.Background(), 1)
+		require.Nil(t, err)
+
+		expectedProposal := &data.GovernanceProposal{
+			Cost:           big.NewInt(100),
+			CommitHash:     "1db734c0315f9ec422b88f679ccfe3e0197b9d67",
+			Nonce:          1,
+			IssuerAddress:  testSCAddressBech32,
+			StartVoteEpoch: 10,
+			EndVoteEpoch:   20,
+			QuorumStake:    big.NewInt(5),
+			Yes:            big.NewInt(3),
+			No:             big.NewInt(0),
+			Veto:           big.NewInt(0),
+			Abstain:        big.NewInt(1),
+			Closed:         true,
+			Passed:         false,
+		}
+		assert.Equal(t, expectedProposal, proposal)
+	})
+}
+
+func TestGovernanceQueryGetter_WithAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	converter, err := core.NewAddressPublicKeyConverter("sov")
+	require.Nil(t, err)
+
+	getter, err := NewGovernanceQueryGetterWithAddressConverter(nil, converter)
+	assert.Equal(t, ErrNilVMQueryGetter, err)
+	assert.True(t, check.IfNil(getter))
+
+	issuer, _ := data.NewAddressFromBech32String(testSCAddressBech32)
+	expectedIssuer, _ := converter.Encode(issuer.AddressBytes())
+	scAddressBytes, _ := core.AddressPublicKeyConverter.Decode(builders.GovernanceSCAddress)
+	expectedSCAddress, _ := converter.Encode(scAddressBytes)
+
+	queriedAddress := ""
+	proxy := createMockProxy([][]byte{
+		{100}, []byte("hash"), {1}, issuer.AddressBytes(), {10}, {20},
+		{5}, {3}, {}, {}, {1}, []byte("true"), []byte("false"),
+	})
+	executeVMQuery := proxy.ExecuteVMQueryCalled
+	proxy.ExecuteVMQueryCalled = func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+		queriedAddress = vmRequest.Address
+		return executeVMQuery(ctx, vmRequest)
+	}
+	queryGetter, err := NewVmQueryGetter(ArgsVmQueryGetter{
+		Proxy: proxy,
+		Log:   logger.GetOrCreate("test"),
+	})
+	require.Nil(t, err)
+
+	getter, err = NewGovernanceQueryGetterWithAddressConverter(queryGetter, converter)
+	require.Nil(t, err)
+
+	proposal, err := getter.GetProposal(context.Background(), 1)
+	require.Nil(t, err)
+	assert.Equal(t, expectedIssuer, proposal.IssuerAddress)
+	assert.Equal(t, expectedSCAddress, queriedAddress)
+}
+
+func TestGovernanceQueryGetter_GetUserVoteHistory(t *testing.T) {
+	t.Parallel()
+
+	user, _ := data.NewAddressFromBech32String(testSCAddressBech32)
+
+	t.Run("invalid list should error", func(t *testing.T) {
+		t.Parallel()
+
+		getter := createGovernanceQueryGetter(t, createMockProxy([][]byte{{1, 2, 3}}))
+		history, err := getter.GetUserVoteHistory(context.Background(), user)
+		assert.Nil(t, history)
+		assert.ErrorIs(t, err, ErrInvalidVMQueryResponse)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		getter := createGovernanceQueryGetter(t, createMockProxy([][]byte{
+			{0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0, 3},
+			{0, 0, 0, 0, 0, 0, 0, 2},
+		}))
+		history, err := getter.GetUserVoteHistory(context.Background(), user)
+		require.Nil(t, err)
+		assert.Equal(t, &data.UserVoteHistory{DirectVotes: []uint64{1, 3}, DelegatedVotes: []uint64{2}}, history)
+	})
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/builders"
 	"github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/data"
 )
@@ -72,3 +73,15 @@ type BlockDataCache interface {
 	Put(key []byte, value interface{}, sizeInBytes int) (evicted bool)
 	IsInterfaceNil() bool
 }
+
+// VMQueryGetter defines the methods able to execute the VM queries created by a builder
+type VMQueryGetter interface {
+	ExecuteQueryFromBuilder(ctx context.Context, builder builders.VMQueryBuilder) ([][]byte, error)
+	IsInterfaceNil() bool
+}
+
+// DNSAddressGenerator defines the component able to compute the DNS contract address that handles a username
+type DNSAddressGenerator interface {
+	CompatibleDNSAddressFromUsername(username string) (core.AddressHandler, error)
+	IsInterfaceNil() bool
+}
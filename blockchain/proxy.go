@@ -44,6 +44,8 @@ type ArgsProxy struct {
 	CacheExpirationTime    time.Duration
 	EntityType             sdkCore.RestAPIEntityType
 	FilterQueryBlockCacher BlockDataCache
+	// AddressConverter is optional, when not provided the default erd prefixed address converter is used
+	AddressConverter sdkCore.AddressConverter
 }
 
 // proxy implements basic functions for interacting with a multiversx Proxy
@@ -74,6 +76,7 @@ func NewProxy(args ArgsProxy) (*proxy, error) {
 		httpClientWrapper: clientWrapper,
 		expirationTime:    args.CacheExpirationTime,
 		endpointProvider:  endpointProvider,
+		addressConverter:  sdkCore.AddressConverterOrDefault(args.AddressConverter),
 	}
 	baseProxyInstance, err := newBaseProxy(baseArgs)
 	if err != nil {
@@ -198,7 +201,7 @@ func (ep *proxy) GetDefaultTransactionArguments(
 		return transaction.FrontendTransaction{}, "", err
 	}
 
-	addressAsBech32String, err := address.AddressAsBech32String()
+	addressAsBech32String, err := ep.addressConverter.Encode(address.AddressBytes())
 	if err != nil {
 		return transaction.FrontendTransaction{}, "", err
 	}
@@ -227,7 +230,7 @@ func (ep *proxy) GetAccount(ctx context.Context, address sdkCore.AddressHandler)
 		return nil, ErrInvalidAddress
 	}
 
-	addressAsBech32, err := address.AddressAsBech32String()
+	addressAsBech32, err := ep.addressConverter.Encode(address.AddressBytes())
 	if err != nil {
 		return nil, err
 	}
@@ -602,7 +605,7 @@ func (ep *proxy) GetESDTTokenData(
 		return nil, ErrInvalidAddress
 	}
 
-	addressAsBech32String, err := address.AddressAsBech32String()
+	addressAsBech32String, err := ep.addressConverter.Encode(address.AddressBytes())
 	if err != nil {
 		return nil, err
 	}
@@ -641,7 +644,7 @@ func (ep *proxy) GetNFTTokenData(
 		return nil, ErrInvalidAddress
 	}
 
-	addressAsBech32String, err := address.AddressAsBech32String()
+	addressAsBech32String, err := ep.addressConverter.Encode(address.AddressBytes())
 	if err != nil {
 		return nil, err
 	}
@@ -673,7 +676,7 @@ func (ep *proxy) GetGuardianData(ctx context.Context, address sdkCore.AddressHan
 	if !address.IsValid() {
 		return nil, ErrInvalidAddress
 	}
-	bech32Address, err := address.AddressAsBech32String()
+	bech32Address, err := ep.addressConverter.Encode(address.AddressBytes())
 	if err != nil {
 		return nil, err
 	}
@@ -707,7 +710,7 @@ func (ep *proxy) IsDataTrieMigrated(ctx context.Context, address sdkCore.Address
 		return false, ErrNilAddress
 	}
 
-	bech32Address, err := address.AddressAsBech32String()
+	bech32Address, err := ep.addressConverter.Encode(address.AddressBytes())
 	if err != nil {
 		return false, err
 	}
@@ -249,6 +249,40 @@ func TestNewProxy(t *testing.T) {
 	})
 }
 
+func TestProxy_WithAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	converter, err := sdkCore.NewAddressPublicKeyConverter("sov")
+	require.Nil(t, err)
+	addressBytes, _ := hex.DecodeString("0139472eff6886771a982f3083da5d421f24c29181e63888228dc81ca60d69e1")
+	expectedBech32, _ := converter.Encode(addressBytes)
+	require.True(t, strings.HasPrefix(expectedBech32, "sov1"))
+
+	queriedURL := ""
+	httpClient := &mockHTTPClient{
+		doCalled: func(req *http.Request) (*http.Response, error) {
+			queriedURL = req.URL.String()
+			accountBytes, _ := json.Marshal(data.AccountResponse{})
+			return &http.Response{
+				Body:       io.NopCloser(bytes.NewReader(accountBytes)),
+				StatusCode: http.StatusOK,
+			}, nil
+		},
+	}
+	args := createMockArgsProxy(httpClient)
+	args.AddressConverter = converter
+	proxyInstance, _ := NewProxy(args)
+
+	_, err = proxyInstance.GetAccount(context.Background(), data.NewAddressFromBytes(addressBytes))
+	require.Nil(t, err)
+	assert.True(t, strings.Contains(queriedURL, expectedBech32))
+
+	queriedURL = ""
+	_, err = proxyInstance.GetShardOfAddress(context.Background(), "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th")
+	assert.NotNil(t, err)
+	assert.Empty(t, queriedURL)
+}
+
 func TestGetAccount(t *testing.T) {
 	t.Parallel()
 
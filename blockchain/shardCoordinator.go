@@ -34,6 +34,11 @@ func (sc *shardCoordinator) ComputeShardId(address sdkCore.AddressHandler) (uint
 	return sc.coordinator.ComputeId(address.AddressBytes()), nil
 }
 
+// NumberOfShards returns the number of shards, without the metachain
+func (sc *shardCoordinator) NumberOfShards() uint32 {
+	return sc.coordinator.NumberOfShards()
+}
+
 // IsInterfaceNil returns true if there is no value under the interface
 func (sc *shardCoordinator) IsInterfaceNil() bool {
 	return sc == nil
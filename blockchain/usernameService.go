@@ -0,0 +1,185 @@
+package blockchain
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// ArgsUsernameService is the DTO used in the username service constructor
+type ArgsUsernameService struct {
+	Proxy            Proxy
+	QueryGetter      VMQueryGetter
+	AddressGenerator DNSAddressGenerator
+	// AddressConverter is optional, when not provided the default erd prefixed address converter is used
+	AddressConverter core.AddressConverter
+}
+
+// usernameService is able to register, resolve and reverse-look-up usernames (herotags) through the DNS contracts
+type usernameService struct {
+	proxy            Proxy
+	queryGetter      VMQueryGetter
+	addressGenerator DNSAddressGenerator
+	addressConverter core.AddressConverter
+}
+
+// NewUsernameService creates a new username service instance
+func NewUsernameService(args ArgsUsernameService) (*usernameService, error) {
+	if check.IfNil(args.Proxy) {
+		return nil, ErrNilProxy
+	}
+	if check.IfNil(args.QueryGetter) {
+		return nil, ErrNilVMQueryGetter
+	}
+	if check.IfNil(args.AddressGenerator) {
+		return nil, ErrNilDNSAddressGenerator
+	}
+
+	return &usernameService{
+		proxy:            args.Proxy,
+		queryGetter:      args.QueryGetter,
+		addressGenerator: args.AddressGenerator,
+		addressConverter: core.AddressConverterOrDefault(args.AddressConverter),
+	}, nil
+}
+
+// GetDNSAddress returns the address of the DNS contract that handles the provided username
+func (service *usernameService) GetDNSAddress(username string) (core.AddressHandler, error) {
+	err := builders.ValidateUsername(username)
+	if err != nil {
+		return nil, err
+	}
+
+	dnsAddress, err := service.addressGenerator.CompatibleDNSAddressFromUsername(username)
+	if err != nil {
+		return nil, err
+	}
+
+	return data.NewAddressFromBytesWithConverter(dnsAddress.AddressBytes(), service.addressConverter), nil
+}
+
+// GetRegistrationCost returns the cost of registering the provided username, as set in its DNS contract
+func (service *usernameService) GetRegistrationCost(ctx context.Context, username string) (*big.Int, error) {
+	dnsAddress, err := service.GetDNSAddress(username)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := builders.NewVMQueryBuilder().
+		Address(dnsAddress).
+		Function("getRegistrationCost")
+	response, err := service.queryGetter.ExecuteQueryFromBuilder(ctx, builder)
+	if err != nil {
+		return nil, err
+	}
+	if len(response) != 1 {
+		return nil, fmt.Errorf("%w, expected one result for getRegistrationCost, got %d", ErrInvalidVMQueryResponse, len(response))
+	}
+
+	return big.NewInt(0).SetBytes(response[0]), nil
+}
+
+// ResolveUsername returns the address that owns the provided username
+func (service *usernameService) ResolveUsername(ctx context.Context, username string) (core.AddressHandler, error) {
+	dnsAddress, err := service.GetDNSAddress(username)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := builders.NewVMQueryBuilder().
+		Address(dnsAddress).
+		Function("resolve").
+		ArgBytes([]byte(username))
+	response, err := service.queryGetter.ExecuteQueryFromBuilder(ctx, builder)
+	if err != nil {
+		return nil, err
+	}
+	if len(response) == 0 || len(response[0]) == 0 {
+		return nil, fmt.Errorf("%w: %s", ErrUsernameNotRegistered, username)
+	}
+
+	address := data.NewAddressFromBytesWithConverter(response[0], service.addressConverter)
+	if !address.IsValid() {
+		return nil, fmt.Errorf("%w, invalid address returned by resolve for %s", ErrInvalidVMQueryResponse, username)
+	}
+
+	return address, nil
+}
+
+// IsUsernameAvailable returns true if the provided username is not yet registered
+func (service *usernameService) IsUsernameAvailable(ctx context.Context, username string) (bool, error) {
+	_, err := service.ResolveUsername(ctx, username)
+	if errors.Is(err, ErrUsernameNotRegistered) {
+		return true, nil
+	}
+	if err != nil {
+		return false, err
+	}
+
+	return false, nil
+}
+
+// GetUsername returns the username of the provided address, as stored on its account
+func (service *usernameService) GetUsername(ctx context.Context, address core.AddressHandler) (string, error) {
+	if check.IfNil(address) {
+		return "", ErrNilAddress
+	}
+
+	account, err := service.proxy.GetAccount(ctx, address)
+	if err != nil {
+		return "", err
+	}
+	if len(account.Username) == 0 {
+		return "", fmt.Errorf("%w: %s", ErrNoUsernameForAddress, account.Address)
+	}
+
+	return account.Username, nil
+}
+
+// CreateRegisterTransaction checks that the username is available and creates the unsigned transaction that
+// registers it for the sender, sent to the username's DNS contract and paying the registration cost
+func (service *usernameService) CreateRegisterTransaction(
+	ctx context.Context,
+	sender *data.Account,
+	username string,
+) (*transaction.FrontendTransaction, error) {
+	available, err := service.IsUsernameAvailable(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	if !available {
+		return nil, fmt.Errorf("%w: %s", ErrUsernameNotAvailable, username)
+	}
+
+	cost, err := service.GetRegistrationCost(ctx, username)
+	if err != nil {
+		return nil, err
+	}
+	dnsAddress, err := service.GetDNSAddress(username)
+	if err != nil {
+		return nil, err
+	}
+	networkConfig, err := service.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	builder, err := builders.NewDNSBuilderWithAddressConverter(networkConfig, service.addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.Register(sender, dnsAddress, username, cost)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (service *usernameService) IsInterfaceNil() bool {
+	return service == nil
+}
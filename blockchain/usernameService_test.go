@@ -0,0 +1,289 @@
+package blockchain
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/vm"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/builders"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testUsername = "alice.elrond"
+
+func createUsernameService(t *testing.T, proxy Proxy) *usernameService {
+	queryGetter, err := NewVmQueryGetter(ArgsVmQueryGetter{
+		Proxy: proxy,
+		Log:   logger.GetOrCreate("test"),
+	})
+	require.Nil(t, err)
+
+	coordinator, _ := NewShardCoordinator(3, 0)
+	addressGenerator, err := NewAddressGenerator(coordinator)
+	require.Nil(t, err)
+
+	service, err := NewUsernameService(ArgsUsernameService{
+		Proxy:            proxy,
+		QueryGetter:      queryGetter,
+		AddressGenerator: addressGenerator,
+	})
+	require.Nil(t, err)
+
+	return service
+}
+
+func createDNSProxyStub(registrationCost *big.Int, owner []byte) *testsCommon.ProxyStub {
+	return &testsCommon.ProxyStub{
+		ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+			returnData := [][]byte{registrationCost.Bytes()}
+			if vmRequest.FuncName == "resolve" {
+				returnData = [][]byte{owner}
+			}
+
+			return &data.VmValuesResponseData{
+				Data: &vm.VMOutputApi{
+					ReturnCode: okCodeAfterExecution,
+					ReturnData: returnData,
+				},
+			}, nil
+		},
+		GetNetworkConfigCalled: func() (*data.NetworkConfig, error) {
+			return &data.NetworkConfig{
+				ChainID:        "T",
+				MinGasLimit:    50000,
+				GasPerDataByte: 1500,
+				MinGasPrice:    1000000000,
+			}, nil
+		},
+	}
+}
+
+func TestNewUsernameService(t *testing.T) {
+	t.Parallel()
+
+	args := ArgsUsernameService{
+		Proxy:            &testsCommon.ProxyStub{},
+		QueryGetter:      &vmQueryGetter{},
+		AddressGenerator: &addressGenerator{},
+	}
+
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		argsCopy := args
+		argsCopy.Proxy = nil
+		service, err := NewUsernameService(argsCopy)
+		assert.True(t, check.IfNil(service))
+		assert.Equal(t, ErrNilProxy, err)
+	})
+	t.Run("nil query getter should error", func(t *testing.T) {
+		t.Parallel()
+
+		argsCopy := args
+		argsCopy.QueryGetter = nil
+		service, err := NewUsernameService(argsCopy)
+		assert.True(t, check.IfNil(service))
+		assert.Equal(t, ErrNilVMQueryGetter, err)
+	})
+	t.Run("nil address generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		argsCopy := args
+		argsCopy.AddressGenerator = nil
+		service, err := NewUsernameService(argsCopy)
+		assert.True(t, check.IfNil(service))
+		assert.Equal(t, ErrNilDNSAddressGenerator, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		service, err := NewUsernameService(args)
+		assert.False(t, check.IfNil(service))
+		assert.Nil(t, err)
+	})
+}
+
+func TestUsernameService_GetDNSAddress(t *testing.T) {
+	t.Parallel()
+
+	service := createUsernameService(t, &testsCommon.ProxyStub{})
+
+	dnsAddress, err := service.GetDNSAddress("alice")
+	assert.Nil(t, dnsAddress)
+	assert.ErrorIs(t, err, builders.ErrInvalidUsername)
+
+	dnsAddress, err = service.GetDNSAddress(testUsername)
+	require.Nil(t, err)
+	expectedAddress, _ := service.addressGenerator.CompatibleDNSAddressFromUsername(testUsername)
+	assert.Equal(t, expectedAddress.AddressBytes(), dnsAddress.AddressBytes())
+}
+
+func TestUsernameService_ResolveAndRegister(t *testing.T) {
+	t.Parallel()
+
+	owner, _ := data.NewAddressFromBech32String(testSCAddressBech32)
+	cost := big.NewInt(1000)
+	sender := &data.Account{Address: testSCAddressBech32, Nonce: 2}
+
+	t.Run("query error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		service := createUsernameService(t, &testsCommon.ProxyStub{
+			ExecuteVMQueryCalled: func(ctx context.Context, vmRequest *data.VmValueRequest) (*data.VmValuesResponseData, error) {
+				return nil, expectedErr
+			},
+		})
+
+		address, err := service.ResolveUsername(context.Background(), testUsername)
+		assert.Nil(t, address)
+		assert.Equal(t, expectedErr, err)
+
+		available, err := service.IsUsernameAvailable(context.Background(), testUsername)
+		assert.False(t, available)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("registered username", func(t *testing.T) {
+		t.Parallel()
+
+		service := createUsernameService(t, createDNSProxyStub(cost, owner.AddressBytes()))
+
+		address, err := service.ResolveUsername(context.Background(), testUsername)
+		require.Nil(t, err)
+		assert.Equal(t, owner.AddressBytes(), address.AddressBytes())
+
+		available, err := service.IsUsernameAvailable(context.Background(), testUsername)
+		assert.Nil(t, err)
+		assert.False(t, available)
+
+		tx, err := service.CreateRegisterTransaction(context.Background(), sender, testUsername)
+		assert.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrUsernameNotAvailable)
+	})
+	t.Run("available username", func(t *testing.T) {
+		t.Parallel()
+
+		service := createUsernameService(t, createDNSProxyStub(cost, make([]byte, 0)))
+
+		address, err := service.ResolveUsername(context.Background(), testUsername)
+		assert.Nil(t, address)
+		assert.ErrorIs(t, err, ErrUsernameNotRegistered)
+
+		available, err := service.IsUsernameAvailable(context.Background(), testUsername)
+		assert.Nil(t, err)
+		assert.True(t, available)
+
+		registrationCost, err := service.GetRegistrationCost(context.Background(), testUsername)
+		assert.Nil(t, err)
+		assert.Equal(t, cost, registrationCost)
+
+		tx, err := service.CreateRegisterTransaction(context.Background(), sender, testUsername)
+		require.Nil(t, err)
+
+		dnsAddress, _ := service.GetDNSAddress(testUsername)
+		dnsAddressAsBech32, _ := dnsAddress.AddressAsBech32String()
+		assert.Equal(t, dnsAddressAsBech32, tx.Receiver)
+		assert.Equal(t, "1000", tx.Value)
+		assert.Equal(t, "register@"+hex.EncodeToString([]byte(testUsername)), string(tx.Data))
+		assert.Equal(t, uint64(2), tx.Nonce)
+	})
+}
+
+func TestUsernameService_WithAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	converter, err := sdkCore.NewAddressPublicKeyConverter("sov")
+	require.Nil(t, err)
+
+	coordinator, _ := NewShardCoordinator(3, 0)
+	addressGenerator, err := NewAddressGenerator(coordinator)
+	require.Nil(t, err)
+	createService := func(proxy Proxy) *usernameService {
+		queryGetter, errCreate := NewVmQueryGetter(ArgsVmQueryGetter{
+			Proxy: proxy,
+			Log:   logger.GetOrCreate("test"),
+		})
+		require.Nil(t, errCreate)
+
+		service, errCreate := NewUsernameService(ArgsUsernameService{
+			Proxy:            proxy,
+			QueryGetter:      queryGetter,
+			AddressGenerator: addressGenerator,
+			AddressConverter: converter,
+		})
+		require.Nil(t, errCreate)
+
+		return service
+	}
+
+	owner, _ := data.NewAddressFromBech32String(testSCAddressBech32)
+	expectedOwner, _ := converter.Encode(owner.AddressBytes())
+	generatedDNSAddress, _ := addressGenerator.CompatibleDNSAddressFromUsername(testUsername)
+	expectedDNSAddress, _ := converter.Encode(generatedDNSAddress.AddressBytes())
+
+	service := createService(createDNSProxyStub(big.NewInt(1000), owner.AddressBytes()))
+	address, err := service.ResolveUsername(context.Background(), testUsername)
+	require.Nil(t, err)
+	ownerAsBech32, err := address.AddressAsBech32String()
+	require.Nil(t, err)
+	assert.Equal(t, expectedOwner, ownerAsBech32)
+
+	dnsAddress, err := service.GetDNSAddress(testUsername)
+	require.Nil(t, err)
+	dnsAddressAsBech32, err := dnsAddress.AddressAsBech32String()
+	require.Nil(t, err)
+	assert.Equal(t, expectedDNSAddress, dnsAddressAsBech32)
+
+	service = createService(createDNSProxyStub(big.NewInt(1000), make([]byte, 0)))
+	tx, err := service.CreateRegisterTransaction(context.Background(), &data.Account{Address: expectedOwner}, testUsername)
+	require.Nil(t, err)
+	assert.Equal(t, expectedDNSAddress, tx.Receiver)
+}
+
+func TestUsernameService_GetUsername(t *testing.T) {
+	t.Parallel()
+
+	address, _ := data.NewAddressFromBech32String(testSCAddressBech32)
+
+	t.Run("nil address should error", func(t *testing.T) {
+		t.Parallel()
+
+		service := createUsernameService(t, &testsCommon.ProxyStub{})
+		username, err := service.GetUsername(context.Background(), nil)
+		assert.Empty(t, username)
+		assert.Equal(t, ErrNilAddress, err)
+	})
+	t.Run("account without username should error", func(t *testing.T) {
+		t.Parallel()
+
+		service := createUsernameService(t, &testsCommon.ProxyStub{
+			GetAccountCalled: func(address sdkCore.AddressHandler) (*data.Account, error) {
+				return &data.Account{Address: testSCAddressBech32}, nil
+			},
+		})
+		username, err := service.GetUsername(context.Background(), address)
+		assert.Empty(t, username)
+		assert.ErrorIs(t, err, ErrNoUsernameForAddress)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		service := createUsernameService(t, &testsCommon.ProxyStub{
+			GetAccountCalled: func(address sdkCore.AddressHandler) (*data.Account, error) {
+				return &data.Account{Address: testSCAddressBech32, Username: testUsername}, nil
+			},
+		})
+		username, err := service.GetUsername(context.Background(), address)
+		assert.Nil(t, err)
+		assert.Equal(t, testUsername, username)
+	})
+}
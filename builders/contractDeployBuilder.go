@@ -0,0 +1,236 @@
+package builders
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	vmcommon "github.com/multiversx/mx-chain-vm-common-go"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	// ContractDeployAddress is the bech32 address used as receiver when deploying a smart contract
+	ContractDeployAddress = "erd1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq6gq4hu"
+
+	wasmVMTypeHex            = "0500"
+	upgradeContractFunction  = "upgradeContract"
+	scDeployEventIdentifier  = "SCDeploy"
+	scUpgradeEventIdentifier = "SCUpgrade"
+)
+
+type contractDeployBuilder struct {
+	senderAccount        *data.Account
+	networkConfig        *data.NetworkConfig
+	contractAddress      string
+	code                 []byte
+	codeMetadata         vmcommon.CodeMetadata
+	args                 [][]byte
+	value                *big.Int
+	gasLimitForExecution uint64
+	addressConverter     core.AddressConverter
+	err                  error
+}
+
+// NewContractDeployBuilder creates a new builder able to generate smart contract deploy and upgrade transactions
+func NewContractDeployBuilder() *contractDeployBuilder {
+	return &contractDeployBuilder{
+		senderAccount:    nil,
+		networkConfig:    nil,
+		addressConverter: core.AddressPublicKeyConverter,
+	}
+}
+
+// SetSenderAccount sets the account that deploys (or owns the upgraded) contract
+func (cdb *contractDeployBuilder) SetSenderAccount(account *data.Account) *contractDeployBuilder {
+	cdb.senderAccount = account
+
+	return cdb
+}
+
+// SetNetworkConfig sets the network config
+func (cdb *contractDeployBuilder) SetNetworkConfig(config *data.NetworkConfig) *contractDeployBuilder {
+	cdb.networkConfig = config
+
+	return cdb
+}
+
+// SetContractAddress sets the bech32 address of the contract to be upgraded. Should not be set for deploys
+func (cdb *contractDeployBuilder) SetContractAddress(contractAddress string) *contractDeployBuilder {
+	cdb.contractAddress = contractAddress
+
+	return cdb
+}
+
+// SetCode sets the contract's wasm code
+func (cdb *contractDeployBuilder) SetCode(code []byte) *contractDeployBuilder {
+	cdb.code = code
+
+	return cdb
+}
+
+// SetCodeFromFile loads the contract's wasm code from the provided .wasm file
+func (cdb *contractDeployBuilder) SetCodeFromFile(filename string) *contractDeployBuilder {
+	code, err := os.ReadFile(filename)
+	if err != nil {
+		cdb.err = fmt.Errorf("%w while reading the contract code from %s", err, filename)
+		return cdb
+	}
+
+	cdb.code = code
+
+	return cdb
+}
+
+// SetCodeMetadata sets the contract's code metadata flags
+func (cdb *contractDeployBuilder) SetCodeMetadata(upgradeable bool, readable bool, payable bool, payableBySC bool) *contractDeployBuilder {
+	cdb.codeMetadata = vmcommon.CodeMetadata{
+		Upgradeable: upgradeable,
+		Readable:    readable,
+		Payable:     payable,
+		PayableBySC: payableBySC,
+	}
+
+	return cdb
+}
+
+// SetArguments sets the already encoded constructor arguments
+func (cdb *contractDeployBuilder) SetArguments(args ...[]byte) *contractDeployBuilder {
+	cdb.args = args
+
+	return cdb
+}
+
+// SetTypedArguments encodes and sets the constructor arguments using the provided encoder (for example, an ABI encoder)
+func (cdb *contractDeployBuilder) SetTypedArguments(encoder ArgumentsEncoder, args ...interface{}) *contractDeployBuilder {
+	if check.IfNil(encoder) {
+		cdb.err = ErrNilArgumentsEncoder
+		return cdb
+	}
+
+	encodedArgs, err := encoder.EncodeConstructorArguments(args...)
+	if err != nil {
+		cdb.err = err
+		return cdb
+	}
+
+	cdb.args = encodedArgs
+
+	return cdb
+}
+
+// SetValue sets the EGLD value transferred to the contract. The contract constructor should be payable
+func (cdb *contractDeployBuilder) SetValue(value *big.Int) *contractDeployBuilder {
+	cdb.value = value
+
+	return cdb
+}
+
+// SetGasLimitForExecution sets the gas limit needed by the contract deployment, without the data field cost
+func (cdb *contractDeployBuilder) SetGasLimitForExecution(gasLimit uint64) *contractDeployBuilder {
+	cdb.gasLimitForExecution = gasLimit
+
+	return cdb
+}
+
+// SetAddressConverter sets the address converter used to decode the bech32 addresses, for the chains that do not use
+// the default address prefix. A nil converter means the default one
+func (cdb *contractDeployBuilder) SetAddressConverter(addressConverter core.AddressConverter) *contractDeployBuilder {
+	cdb.addressConverter = core.AddressConverterOrDefault(addressConverter)
+
+	return cdb
+}
+
+// Build builds the deploy transaction or, if the contract address was set, the upgrade transaction
+// The returned transaction will not be signed
+func (cdb *contractDeployBuilder) Build() (*transaction.FrontendTransaction, error) {
+	if cdb.err != nil {
+		return nil, cdb.err
+	}
+	if len(cdb.code) == 0 {
+		return nil, ErrEmptyContractCode
+	}
+	if cdb.gasLimitForExecution == 0 {
+		return nil, ErrInvalidGasLimitNeededForContractCall
+	}
+
+	receiver, err := encodeSystemAddress(ContractDeployAddress, cdb.addressConverter)
+	if err != nil {
+		return nil, err
+	}
+	dataBuilder := NewTxDataBuilder().
+		ArgBytes(cdb.code).
+		ArgHexString(wasmVMTypeHex)
+	if len(cdb.contractAddress) > 0 {
+		_, err = data.NewAddressFromBech32StringWithConverter(cdb.contractAddress, cdb.addressConverter)
+		if err != nil {
+			return nil, fmt.Errorf("%w for contract address %s", err, cdb.contractAddress)
+		}
+
+		// the upgrade is a contract call on the existing contract that does not carry the VM type
+		receiver = cdb.contractAddress
+		dataBuilder = NewTxDataBuilder().
+			Function(upgradeContractFunction).
+			ArgBytes(cdb.code)
+	}
+
+	dataBuilder.ArgHexString(hex.EncodeToString(cdb.codeMetadata.ToBytes()))
+	for _, arg := range cdb.args {
+		dataBuilder.ArgHexString(hex.EncodeToString(arg))
+	}
+
+	return createSCCallTransaction(argsSCCallTransaction{
+		networkConfig: cdb.networkConfig,
+		sender:        cdb.senderAccount,
+		receiver:      receiver,
+		value:         cdb.value,
+		dataBuilder:   dataBuilder,
+		executionGas:  cdb.gasLimitForExecution,
+	})
+}
+
+// ComputeContractAddress predicts the address of the contract deployed by the sender account at its current nonce
+func (cdb *contractDeployBuilder) ComputeContractAddress(generator AddressGenerator) (core.AddressHandler, error) {
+	if check.IfNil(generator) {
+		return nil, ErrNilAddressGenerator
+	}
+	if cdb.senderAccount == nil {
+		return nil, ErrNilSenderAccount
+	}
+
+	senderAddress, err := data.NewAddressFromBech32StringWithConverter(cdb.senderAccount.Address, cdb.addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return generator.ComputeWasmVMScAddress(senderAddress, cdb.senderAccount.Nonce)
+}
+
+// ParseDeployedContractAddress returns the bech32 address of the contract deployed (or upgraded) by the provided transaction,
+// as found in the SCDeploy (or SCUpgrade) event
+func ParseDeployedContractAddress(tx *data.TransactionOnNetwork) (string, error) {
+	if tx == nil {
+		return "", ErrNilTransactionOnNetwork
+	}
+
+	events := getAllEvents(tx)
+	err := checkSignalErrorEvents(events)
+	if err != nil {
+		return "", err
+	}
+
+	for _, event := range events {
+		if event == nil {
+			continue
+		}
+		if event.Identifier == scDeployEventIdentifier || event.Identifier == scUpgradeEventIdentifier {
+			return event.Address, nil
+		}
+	}
+
+	return "", ErrEventNotFound
+}
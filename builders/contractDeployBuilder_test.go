@@ -0,0 +1,188 @@
+package builders
+
+import (
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestContractDeployBuilder_Build(t *testing.T) {
+	t.Parallel()
+
+	senderAccount := &data.Account{
+		Address: testTokenSender,
+		Nonce:   12,
+	}
+	code := []byte{0x00, 0x61, 0x73, 0x6d}
+
+	t.Run("missing code should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := NewContractDeployBuilder().
+			SetSenderAccount(senderAccount).
+			SetNetworkConfig(createTokenTransferNetworkConfig()).
+			SetGasLimitForExecution(1000).
+			Build()
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrEmptyContractCode, err)
+	})
+	t.Run("missing code file should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := NewContractDeployBuilder().
+			SetSenderAccount(senderAccount).
+			SetNetworkConfig(createTokenTransferNetworkConfig()).
+			SetCodeFromFile(filepath.Join(t.TempDir(), "missing.wasm")).
+			SetGasLimitForExecution(1000).
+			Build()
+		assert.Nil(t, tx)
+		assert.NotNil(t, err)
+	})
+	t.Run("arguments encoder errors should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		tx, err := NewContractDeployBuilder().
+			SetSenderAccount(senderAccount).
+			SetNetworkConfig(createTokenTransferNetworkConfig()).
+			SetCode(code).
+			SetTypedArguments(&testsCommon.ArgumentsEncoderStub{
+				EncodeConstructorArgumentsCalled: func(args ...interface{}) ([][]byte, error) {
+					return nil, expectedErr
+				},
+			}, 1).
+			SetGasLimitForExecution(1000).
+			Build()
+		assert.Nil(t, tx)
+		assert.Equal(t, expectedErr, err)
+	})
+	t.Run("deploy should work", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(t.TempDir(), "contract.wasm")
+		err := os.WriteFile(filename, code, 0644)
+		require.Nil(t, err)
+
+		tx, err := NewContractDeployBuilder().
+			SetSenderAccount(senderAccount).
+			SetNetworkConfig(createTokenTransferNetworkConfig()).
+			SetCodeFromFile(filename).
+			SetCodeMetadata(true, true, false, true).
+			SetTypedArguments(&testsCommon.ArgumentsEncoderStub{
+				EncodeConstructorArgumentsCalled: func(args ...interface{}) ([][]byte, error) {
+					return [][]byte{{byte(args[0].(int))}, {}}, nil
+				},
+			}, 7).
+			SetValue(big.NewInt(10)).
+			SetGasLimitForExecution(5000000).
+			Build()
+		require.Nil(t, err)
+
+		expectedData := "0061736d@0500@0504@07@"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, ContractDeployAddress, tx.Receiver)
+		assert.Equal(t, testTokenSender, tx.Sender)
+		assert.Equal(t, "10", tx.Value)
+		assert.Equal(t, uint64(12), tx.Nonce)
+		assert.Equal(t, uint64(50000+1500*len(expectedData)+5000000), tx.GasLimit)
+	})
+	t.Run("upgrade should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := NewContractDeployBuilder().
+			SetSenderAccount(senderAccount).
+			SetNetworkConfig(createTokenTransferNetworkConfig()).
+			SetContractAddress(testTokenReceiver).
+			SetCode(code).
+			SetCodeMetadata(true, false, true, false).
+			SetArguments([]byte("arg")).
+			SetGasLimitForExecution(5000000).
+			Build()
+		require.Nil(t, err)
+
+		assert.Equal(t, "upgradeContract@0061736d@0102@617267", string(tx.Data))
+		assert.Equal(t, testTokenReceiver, tx.Receiver)
+		assert.Equal(t, "0", tx.Value)
+	})
+}
+
+func TestContractDeployBuilder_ComputeContractAddress(t *testing.T) {
+	t.Parallel()
+
+	builder := NewContractDeployBuilder()
+	address, err := builder.ComputeContractAddress(nil)
+	assert.Nil(t, address)
+	assert.Equal(t, ErrNilAddressGenerator, err)
+
+	generator := &testsCommon.AddressGeneratorStub{
+		ComputeWasmVMScAddressCalled: func(address core.AddressHandler, nonce uint64) (core.AddressHandler, error) {
+			bech32, _ := address.AddressAsBech32String()
+			assert.Equal(t, testTokenSender, bech32)
+			assert.Equal(t, uint64(12), nonce)
+
+			return data.NewAddressFromBech32String(testTokenReceiver)
+		},
+	}
+	address, err = builder.ComputeContractAddress(generator)
+	assert.Nil(t, address)
+	assert.Equal(t, ErrNilSenderAccount, err)
+
+	builder.SetSenderAccount(&data.Account{
+		Address: testTokenSender,
+		Nonce:   12,
+	})
+	address, err = builder.ComputeContractAddress(generator)
+	require.Nil(t, err)
+	bech32, _ := address.AddressAsBech32String()
+	assert.Equal(t, testTokenReceiver, bech32)
+}
+
+func TestParseDeployedContractAddress(t *testing.T) {
+	t.Parallel()
+
+	address, err := ParseDeployedContractAddress(&data.TransactionOnNetwork{})
+	assert.Empty(t, address)
+	assert.Equal(t, ErrEventNotFound, err)
+
+	tx := &data.TransactionOnNetwork{
+		Logs: &transaction.ApiLogs{
+			Events: []*transaction.Events{
+				{
+					Address:    testTokenReceiver,
+					Identifier: "SCDeploy",
+				},
+			},
+		},
+	}
+	address, err = ParseDeployedContractAddress(tx)
+	assert.Nil(t, err)
+	assert.Equal(t, testTokenReceiver, address)
+}
+
+func TestContractDeployBuilder_SetAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	converter := createTestAddressConverter(t)
+	senderAccount := &data.Account{
+		Address: convertTestAddress(t, testTokenSender, converter),
+		Nonce:   12,
+	}
+	tx, err := NewContractDeployBuilder().
+		SetSenderAccount(senderAccount).
+		SetNetworkConfig(createTokenTransferNetworkConfig()).
+		SetAddressConverter(converter).
+		SetCode([]byte{0x00, 0x61, 0x73, 0x6d}).
+		SetGasLimitForExecution(5000000).
+		Build()
+	require.Nil(t, err)
+	assert.Equal(t, convertTestAddress(t, ContractDeployAddress, converter), tx.Receiver)
+}
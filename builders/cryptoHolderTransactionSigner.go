@@ -0,0 +1,53 @@
+package builders
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+// cryptoHolderTransactionSigner is the transaction signer backed by a private key held in memory
+type cryptoHolderTransactionSigner struct {
+	signer       Signer
+	cryptoHolder core.CryptoComponentsHolder
+}
+
+// NewCryptoHolderTransactionSigner creates a transaction signer that signs with the private key of the crypto holder
+func NewCryptoHolderTransactionSigner(signer Signer, cryptoHolder core.CryptoComponentsHolder) (*cryptoHolderTransactionSigner, error) {
+	if check.IfNil(signer) {
+		return nil, ErrNilSigner
+	}
+	if check.IfNil(cryptoHolder) {
+		return nil, ErrNilCryptoComponentsHolder
+	}
+
+	return &cryptoHolderTransactionSigner{
+		signer:       signer,
+		cryptoHolder: cryptoHolder,
+	}, nil
+}
+
+// GetBech32 returns the bech32 address of the crypto holder
+func (holderSigner *cryptoHolderTransactionSigner) GetBech32() string {
+	return holderSigner.cryptoHolder.GetBech32()
+}
+
+// GetAddressHandler returns the address of the crypto holder
+func (holderSigner *cryptoHolderTransactionSigner) GetAddressHandler() core.AddressHandler {
+	return holderSigner.cryptoHolder.GetAddressHandler()
+}
+
+// SignTransaction signs the data computed from the transaction
+func (holderSigner *cryptoHolderTransactionSigner) SignTransaction(_ *transaction.FrontendTransaction, dataForSigning []byte) ([]byte, error) {
+	return holderSigner.signer.SignByteSlice(dataForSigning, holderSigner.cryptoHolder.GetPrivateKey())
+}
+
+// SignMessage signs the message, prepending the standard message prefix
+func (holderSigner *cryptoHolderTransactionSigner) SignMessage(message []byte) ([]byte, error) {
+	return holderSigner.signer.SignMessage(message, holderSigner.cryptoHolder.GetPrivateKey())
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (holderSigner *cryptoHolderTransactionSigner) IsInterfaceNil() bool {
+	return holderSigner == nil
+}
@@ -0,0 +1,116 @@
+package builders
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	// UsernameSuffix is the suffix that all the usernames (herotags) registered through the DNS contracts have
+	UsernameSuffix = ".elrond"
+
+	minUsernameLength = 3
+	maxUsernameLength = 25
+
+	// the registration is an asynchronous call from the DNS contract to the user's shard
+	gasLimitDNSRegister = 50000000
+)
+
+type dnsBuilder struct {
+	networkConfig    *data.NetworkConfig
+	addressConverter core.AddressConverter
+}
+
+// NewDNSBuilder creates a new builder able to generate the DNS contracts transactions
+func NewDNSBuilder(networkConfig *data.NetworkConfig) (*dnsBuilder, error) {
+	return NewDNSBuilderWithAddressConverter(networkConfig, core.AddressPublicKeyConverter)
+}
+
+// NewDNSBuilderWithAddressConverter creates a new DNS builder that encodes the DNS contract address using the provided
+// address converter, for the chains that do not use the default address prefix. A nil converter means the default one
+func NewDNSBuilderWithAddressConverter(
+	networkConfig *data.NetworkConfig,
+	addressConverter core.AddressConverter,
+) (*dnsBuilder, error) {
+	if networkConfig == nil {
+		return nil, ErrNilNetworkConfig
+	}
+
+	return &dnsBuilder{
+		networkConfig:    networkConfig,
+		addressConverter: core.AddressConverterOrDefault(addressConverter),
+	}, nil
+}
+
+// Register builds the transaction that registers the username (herotag) for the sender. The DNS address should be the
+// one computed for the username (see the address generator's CompatibleDNSAddressFromUsername) and the registration
+// cost should be the one returned by the same DNS contract
+func (builder *dnsBuilder) Register(
+	sender *data.Account,
+	dnsAddress core.AddressHandler,
+	username string,
+	registrationCost *big.Int,
+) (*transaction.FrontendTransaction, error) {
+	if check.IfNil(dnsAddress) {
+		return nil, ErrNilAddress
+	}
+	if registrationCost == nil {
+		return nil, ErrNilValue
+	}
+	err := ValidateUsername(username)
+	if err != nil {
+		return nil, err
+	}
+
+	dnsAddressAsBech32, err := builder.addressConverter.Encode(dnsAddress.AddressBytes())
+	if err != nil {
+		return nil, err
+	}
+
+	dataBuilder := NewTxDataBuilder().
+		Function("register").
+		ArgBytes([]byte(username))
+
+	return createSCCallTransaction(argsSCCallTransaction{
+		networkConfig: builder.networkConfig,
+		sender:        sender,
+		receiver:      dnsAddressAsBech32,
+		value:         registrationCost,
+		dataBuilder:   dataBuilder,
+		executionGas:  gasLimitDNSRegister,
+	})
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (builder *dnsBuilder) IsInterfaceNil() bool {
+	return builder == nil
+}
+
+// ValidateUsername checks that the username has the DNS suffix and that the name before the suffix only contains
+// lowercase alphanumeric characters and has a valid length
+func ValidateUsername(username string) error {
+	if !strings.HasSuffix(username, UsernameSuffix) {
+		return fmt.Errorf("%w: %s does not end with %s", ErrInvalidUsername, username, UsernameSuffix)
+	}
+
+	name := strings.TrimSuffix(username, UsernameSuffix)
+	if len(name) < minUsernameLength || len(name) > maxUsernameLength {
+		return fmt.Errorf("%w: %s should have between %d and %d characters before the suffix",
+			ErrInvalidUsername, username, minUsernameLength, maxUsernameLength)
+	}
+
+	for _, c := range name {
+		isLowercaseAlphanumeric := (c >= 'a' && c <= 'z') || (c >= '0' && c <= '9')
+		if !isLowercaseAlphanumeric {
+			return fmt.Errorf("%w: %s contains the invalid character %q", ErrInvalidUsername, username, c)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,116 @@
+package builders
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewDNSBuilder(t *testing.T) {
+	t.Parallel()
+
+	builder, err := NewDNSBuilder(nil)
+	assert.True(t, check.IfNil(builder))
+	assert.Equal(t, ErrNilNetworkConfig, err)
+
+	builder, err = NewDNSBuilder(createTokenTransferNetworkConfig())
+	assert.False(t, check.IfNil(builder))
+	assert.Nil(t, err)
+}
+
+func TestDNSBuilder_Register(t *testing.T) {
+	t.Parallel()
+
+	sender := &data.Account{Address: testTokenSender, Nonce: 7}
+	dnsAddress, _ := data.NewAddressFromBech32String(testTokenReceiver)
+	cost := big.NewInt(1000)
+	builder, _ := NewDNSBuilder(createTokenTransferNetworkConfig())
+
+	t.Run("nil DNS address should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Register(sender, nil, "alice.elrond", cost)
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNilAddress, err)
+	})
+	t.Run("nil registration cost should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Register(sender, dnsAddress, "alice.elrond", nil)
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNilValue, err)
+	})
+	t.Run("invalid username should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Register(sender, dnsAddress, "alice", cost)
+		assert.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrInvalidUsername)
+	})
+	t.Run("nil sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Register(nil, dnsAddress, "alice.elrond", cost)
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNilSenderAccount, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Register(sender, dnsAddress, "alice.elrond", cost)
+		require.Nil(t, err)
+
+		expectedData := "register@" + hex.EncodeToString([]byte("alice.elrond"))
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, testTokenReceiver, tx.Receiver)
+		assert.Equal(t, testTokenSender, tx.Sender)
+		assert.Equal(t, "1000", tx.Value)
+		assert.Equal(t, uint64(7), tx.Nonce)
+		assert.Equal(t, uint64(50000+1500*len(expectedData)+gasLimitDNSRegister), tx.GasLimit)
+	})
+}
+
+func TestDNSBuilder_WithAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	converter := createTestAddressConverter(t)
+	builder, err := NewDNSBuilderWithAddressConverter(nil, converter)
+	assert.True(t, check.IfNil(builder))
+	assert.Equal(t, ErrNilNetworkConfig, err)
+
+	builder, err = NewDNSBuilderWithAddressConverter(createTokenTransferNetworkConfig(), converter)
+	require.Nil(t, err)
+
+	sender := &data.Account{Address: convertTestAddress(t, testTokenSender, converter), Nonce: 7}
+	dnsAddress, _ := data.NewAddressFromBech32String(testTokenReceiver)
+	tx, err := builder.Register(sender, dnsAddress, "alice.elrond", big.NewInt(1000))
+	require.Nil(t, err)
+	assert.Equal(t, convertTestAddress(t, testTokenReceiver, converter), tx.Receiver)
+}
+
+func TestValidateUsername(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, ValidateUsername("alice.elrond"))
+	assert.Nil(t, ValidateUsername("alice01.elrond"))
+	assert.Nil(t, ValidateUsername("abc.elrond"))
+
+	invalidUsernames := []string{
+		"",
+		"alice",
+		"alice.elrond.com",
+		"ab.elrond",
+		"abcdefghijklmnopqrstuvwxyz.elrond",
+		"Alice.elrond",
+		"ali-ce.elrond",
+		"ali.ce.elrond",
+	}
+	for _, username := range invalidUsernames {
+		assert.ErrorIs(t, ValidateUsername(username), ErrInvalidUsername, username)
+	}
+}
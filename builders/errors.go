@@ -49,3 +49,105 @@ var ErrGuardianDoesNotMatch = errors.New("configured guardian does not match sig
 
 // ErrRelayerDoesNotMatch signals a mismatch between the configured relayer in tx and the signing relayer address
 var ErrRelayerDoesNotMatch = errors.New("configured relayer does not match signing relayer")
+
+// ErrNilSenderAccount signals that a nil sender account was provided
+var ErrNilSenderAccount = errors.New("nil sender account")
+
+// ErrNoTokenTransfers signals that no token transfers were provided
+var ErrNoTokenTransfers = errors.New("no token transfers")
+
+// ErrInvalidTokenIdentifier signals that an invalid token identifier was provided
+var ErrInvalidTokenIdentifier = errors.New("invalid token identifier")
+
+// ErrMissingContractFunction signals that contract call arguments were provided without a function
+var ErrMissingContractFunction = errors.New("missing contract function")
+
+// ErrInvalidGasLimitNeededForContractCall signals that an invalid gas limit needed for the contract call was provided
+var ErrInvalidGasLimitNeededForContractCall = errors.New("invalid gas limit needed for contract call")
+
+// ErrInvalidTokenType signals that an invalid token type was provided
+var ErrInvalidTokenType = errors.New("invalid token type")
+
+// ErrNoRolesProvided signals that no roles were provided
+var ErrNoRolesProvided = errors.New("no roles provided")
+
+// ErrNoPropertiesProvided signals that no token properties were provided
+var ErrNoPropertiesProvided = errors.New("no properties provided")
+
+// ErrInvalidQueryResult signals that an invalid VM query result was provided
+var ErrInvalidQueryResult = errors.New("invalid query result")
+
+// ErrNilTransactionOnNetwork signals that a nil transaction on network was provided
+var ErrNilTransactionOnNetwork = errors.New("nil transaction on network")
+
+// ErrEventNotFound signals that the expected event was not found in the transaction's logs
+var ErrEventNotFound = errors.New("event not found")
+
+// ErrTransactionFailed signals that the transaction execution failed
+var ErrTransactionFailed = errors.New("transaction failed")
+
+// ErrInvalidRoyalties signals that an invalid royalties value was provided
+var ErrInvalidRoyalties = errors.New("invalid royalties")
+
+// ErrNoURIsProvided signals that no URIs were provided
+var ErrNoURIsProvided = errors.New("no URIs provided")
+
+// ErrEmptyContractCode signals that an empty contract code was provided
+var ErrEmptyContractCode = errors.New("empty contract code")
+
+// ErrNilArgumentsEncoder signals that a nil arguments encoder was provided
+var ErrNilArgumentsEncoder = errors.New("nil arguments encoder")
+
+// ErrNilAddressGenerator signals that a nil address generator was provided
+var ErrNilAddressGenerator = errors.New("nil address generator")
+
+// ErrNestedRelayedTransaction signals that a relayed transaction was provided as the inner transaction of another relayed transaction
+var ErrNestedRelayedTransaction = errors.New("nested relayed transactions are not allowed")
+
+// ErrNoValidatorNodesProvided signals that no validator nodes (BLS keys) were provided
+var ErrNoValidatorNodesProvided = errors.New("no validator nodes provided")
+
+// ErrNilGovernanceConfig signals that a nil governance config (or one without the proposal fee) was provided
+var ErrNilGovernanceConfig = errors.New("nil governance config")
+
+// ErrEmptyCommitHash signals that an empty proposal commit hash was provided
+var ErrEmptyCommitHash = errors.New("empty commit hash")
+
+// ErrInvalidVotingPeriod signals that the proposal voting period is invalid
+var ErrInvalidVotingPeriod = errors.New("invalid voting period")
+
+// ErrInvalidVoteType signals that an invalid vote type was provided
+var ErrInvalidVoteType = errors.New("invalid vote type")
+
+// ErrEmptyServiceUID signals that an empty guardian service UID was provided
+var ErrEmptyServiceUID = errors.New("empty service UID")
+
+// ErrNilTransaction signals that a nil transaction was provided
+var ErrNilTransaction = errors.New("nil transaction")
+
+// ErrInvalidUsername signals that an invalid username (herotag) was provided
+var ErrInvalidUsername = errors.New("invalid username")
+
+// ErrMissingChainID signals that the chain ID is missing from the network config
+var ErrMissingChainID = errors.New("missing chain ID")
+
+// ErrDataTooLarge signals that the transaction's data field is too large
+var ErrDataTooLarge = errors.New("data field too large")
+
+// ErrInvalidTransactionOptions signals that the transaction's options are invalid
+var ErrInvalidTransactionOptions = errors.New("invalid transaction options")
+
+// ErrInvalidGasPrice signals that the gas price is lower than the network's minimum gas price
+var ErrInvalidGasPrice = errors.New("invalid gas price")
+
+// ErrInvalidTxData signals that the transaction's data field could not be parsed
+var ErrInvalidTxData = errors.New("invalid transaction data field")
+
+// ErrNilTransactionSigner signals that a nil transaction signer was provided
+var ErrNilTransactionSigner = errors.New("nil transaction signer")
+
+// ErrRelayerIsSender signals that the relayer of a relayed transaction v3 is the sender itself
+var ErrRelayerIsSender = errors.New("the relayer can not be the sender of the transaction")
+
+// ErrRelayerInDifferentShard signals that the relayer of a relayed transaction v3 is not in the sender's shard
+var ErrRelayerInDifferentShard = errors.New("the relayer must be in the same shard as the sender")
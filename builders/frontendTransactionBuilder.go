@@ -0,0 +1,306 @@
+package builders
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	minTransactionVersionWithOptions = 2
+	// a transaction can not exceed the maximum size of an intercepted message
+	maxTransactionDataSize  = core.MegabyteSize
+	knownTransactionOptions = transaction.MaskSignedWithHash | transaction.MaskGuardedTransaction
+)
+
+type frontendTransactionBuilder struct {
+	networkConfig    *data.NetworkConfig
+	addressConverter sdkCore.AddressConverter
+	sender           *data.Account
+	nonce            *uint64
+	receiver         string
+	value            *big.Int
+	amount           *data.Amount
+	dataBuilder      TxDataBuilder
+	gasLimit         uint64
+	gasEstimator     GasEstimator
+	gasPrice         uint64
+	guardian         string
+	relayer          string
+	options          uint32
+}
+
+// NewFrontendTransactionBuilder creates a new builder able to assemble and validate unsigned transactions. The chain ID,
+// the minimum gas price and the version are taken from the network config, while the version and the options are adjusted
+// for the guarded, hash-signed and relayed transactions
+func NewFrontendTransactionBuilder() *frontendTransactionBuilder {
+	return &frontendTransactionBuilder{
+		networkConfig:    nil,
+		addressConverter: sdkCore.AddressPublicKeyConverter,
+		sender:           nil,
+	}
+}
+
+// SetNetworkConfig sets the (cached) network config
+func (builder *frontendTransactionBuilder) SetNetworkConfig(config *data.NetworkConfig) *frontendTransactionBuilder {
+	builder.networkConfig = config
+
+	return builder
+}
+
+// SetAddressConverter sets the address converter used to validate the bech32 addresses, for the chains that do not use
+// the default address prefix. A nil converter means the default one
+func (builder *frontendTransactionBuilder) SetAddressConverter(addressConverter sdkCore.AddressConverter) *frontendTransactionBuilder {
+	builder.addressConverter = sdkCore.AddressConverterOrDefault(addressConverter)
+
+	return builder
+}
+
+// SetSender sets the sender account. The transaction nonce will be the account's nonce, unless set through SetNonce
+func (builder *frontendTransactionBuilder) SetSender(sender *data.Account) *frontendTransactionBuilder {
+	builder.sender = sender
+
+	return builder
+}
+
+// SetNonce overrides the nonce of the sender account
+func (builder *frontendTransactionBuilder) SetNonce(nonce uint64) *frontendTransactionBuilder {
+	builder.nonce = &nonce
+
+	return builder
+}
+
+// SetReceiver sets the bech32 address of the receiver
+func (builder *frontendTransactionBuilder) SetReceiver(receiver string) *frontendTransactionBuilder {
+	builder.receiver = receiver
+
+	return builder
+}
+
+// SetValue sets the denominated EGLD value
+func (builder *frontendTransactionBuilder) SetValue(value *big.Int) *frontendTransactionBuilder {
+	builder.value = value
+	builder.amount = nil
+
+	return builder
+}
+
+// SetAmount sets the EGLD value from an amount
+func (builder *frontendTransactionBuilder) SetAmount(amount *data.Amount) *frontendTransactionBuilder {
+	builder.amount = amount
+	builder.value = nil
+
+	return builder
+}
+
+// SetData sets the data field, as generated by the provided data builder
+func (builder *frontendTransactionBuilder) SetData(dataBuilder TxDataBuilder) *frontendTransactionBuilder {
+	builder.dataBuilder = dataBuilder
+
+	return builder
+}
+
+// SetGasLimit sets the gas limit. An explicitly set gas limit takes precedence over the gas estimator
+func (builder *frontendTransactionBuilder) SetGasLimit(gasLimit uint64) *frontendTransactionBuilder {
+	builder.gasLimit = gasLimit
+
+	return builder
+}
+
+// SetGasEstimator sets the component used to compute the gas limit, if not explicitly set
+func (builder *frontendTransactionBuilder) SetGasEstimator(estimator GasEstimator) *frontendTransactionBuilder {
+	builder.gasEstimator = estimator
+
+	return builder
+}
+
+// SetGasPrice sets the gas price. The network's minimum gas price is used if not set
+func (builder *frontendTransactionBuilder) SetGasPrice(gasPrice uint64) *frontendTransactionBuilder {
+	builder.gasPrice = gasPrice
+
+	return builder
+}
+
+// SetGuardian marks the transaction as guarded by the provided guardian
+func (builder *frontendTransactionBuilder) SetGuardian(guardian string) *frontendTransactionBuilder {
+	builder.guardian = guardian
+	builder.options |= transaction.MaskGuardedTransaction
+
+	return builder
+}
+
+// SetSignWithHash marks the transaction to be signed over its hash instead of its serialized form
+func (builder *frontendTransactionBuilder) SetSignWithHash() *frontendTransactionBuilder {
+	builder.options |= transaction.MaskSignedWithHash
+
+	return builder
+}
+
+// SetRelayer sets the relayer (v3) that will pay the transaction fee
+func (builder *frontendTransactionBuilder) SetRelayer(relayer string) *frontendTransactionBuilder {
+	builder.relayer = relayer
+
+	return builder
+}
+
+// SetOptions sets raw options, ORed with the ones resulted from the other setters
+func (builder *frontendTransactionBuilder) SetOptions(options uint32) *frontendTransactionBuilder {
+	builder.options |= options
+
+	return builder
+}
+
+// Build validates the fields and assembles the unsigned transaction. If the gas limit was not set, it is computed by the
+// gas estimator or, if no estimator was set, as the move balance gas limit computed from the network config.
+// The returned transaction should be signed by the txBuilder
+func (builder *frontendTransactionBuilder) Build(ctx context.Context) (*transaction.FrontendTransaction, error) {
+	err := builder.checkFields()
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := builder.getValue()
+	if err != nil {
+		return nil, err
+	}
+
+	var payload []byte
+	if !check.IfNil(builder.dataBuilder) {
+		payload, err = builder.dataBuilder.ToDataBytes()
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(payload) > maxTransactionDataSize {
+		return nil, fmt.Errorf("%w: %d bytes, maximum is %d", ErrDataTooLarge, len(payload), maxTransactionDataSize)
+	}
+
+	tx := &transaction.FrontendTransaction{
+		Nonce:        builder.sender.Nonce,
+		Value:        value,
+		Receiver:     builder.receiver,
+		Sender:       builder.sender.Address,
+		GasPrice:     builder.networkConfig.MinGasPrice,
+		Data:         payload,
+		ChainID:      builder.networkConfig.ChainID,
+		Version:      builder.networkConfig.MinTransactionVersion,
+		Options:      builder.options,
+		GuardianAddr: builder.guardian,
+		RelayerAddr:  builder.relayer,
+	}
+	if builder.nonce != nil {
+		tx.Nonce = *builder.nonce
+	}
+	if builder.gasPrice > 0 {
+		tx.GasPrice = builder.gasPrice
+	}
+	needsNewerVersion := tx.Options != 0 || len(tx.RelayerAddr) > 0
+	if needsNewerVersion && tx.Version < minTransactionVersionWithOptions {
+		tx.Version = minTransactionVersionWithOptions
+	}
+
+	tx.GasLimit, err = builder.computeGasLimit(ctx, tx)
+	if err != nil {
+		return nil, err
+	}
+
+	err = builder.checkTransaction(tx)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func (builder *frontendTransactionBuilder) checkFields() error {
+	if builder.networkConfig == nil {
+		return ErrNilNetworkConfig
+	}
+	if len(builder.networkConfig.ChainID) == 0 {
+		return ErrMissingChainID
+	}
+	if builder.sender == nil {
+		return ErrNilSenderAccount
+	}
+
+	err := builder.checkBech32Address(builder.sender.Address, "sender")
+	if err != nil {
+		return err
+	}
+	err = builder.checkBech32Address(builder.receiver, "receiver")
+	if err != nil {
+		return err
+	}
+	if len(builder.relayer) > 0 {
+		err = builder.checkBech32Address(builder.relayer, "relayer")
+		if err != nil {
+			return err
+		}
+	}
+
+	isGuarded := builder.options&transaction.MaskGuardedTransaction != 0
+	if isGuarded != (len(builder.guardian) > 0) {
+		return fmt.Errorf("%w: the guarded option and the guardian address should be set together", ErrInvalidTransactionOptions)
+	}
+	if isGuarded {
+		return builder.checkBech32Address(builder.guardian, "guardian")
+	}
+
+	return nil
+}
+
+func (builder *frontendTransactionBuilder) getValue() (string, error) {
+	if builder.amount != nil {
+		return builder.amount.ToTransactionValue()
+	}
+	if builder.value == nil {
+		return "0", nil
+	}
+	if builder.value.Sign() < 0 {
+		return "", fmt.Errorf("%w: negative value %s", ErrInvalidValue, builder.value.String())
+	}
+
+	return builder.value.String(), nil
+}
+
+func (builder *frontendTransactionBuilder) computeGasLimit(ctx context.Context, tx *transaction.FrontendTransaction) (uint64, error) {
+	if builder.gasLimit > 0 {
+		return builder.gasLimit, nil
+	}
+	if !check.IfNil(builder.gasEstimator) {
+		return builder.gasEstimator.EstimateGasLimit(ctx, tx)
+	}
+
+	return builder.networkConfig.ComputeMoveBalanceGasLimit(tx), nil
+}
+
+func (builder *frontendTransactionBuilder) checkTransaction(tx *transaction.FrontendTransaction) error {
+	if tx.Options&^knownTransactionOptions != 0 {
+		return fmt.Errorf("%w: unknown options bits %b", ErrInvalidTransactionOptions, tx.Options&^knownTransactionOptions)
+	}
+	if tx.GasPrice < builder.networkConfig.MinGasPrice {
+		return fmt.Errorf("%w: %d, minimum is %d", ErrInvalidGasPrice, tx.GasPrice, builder.networkConfig.MinGasPrice)
+	}
+
+	minGasLimit := builder.networkConfig.ComputeMoveBalanceGasLimit(tx)
+	if tx.GasLimit < minGasLimit {
+		return fmt.Errorf("%w: %d, minimum is %d", data.ErrInsufficientGasLimit, tx.GasLimit, minGasLimit)
+	}
+
+	return nil
+}
+
+func (builder *frontendTransactionBuilder) checkBech32Address(address string, name string) error {
+	_, err := data.NewAddressFromBech32StringWithConverter(address, builder.addressConverter)
+	if err != nil {
+		return fmt.Errorf("%w for %s %q", ErrInvalidAddress, name, address)
+	}
+
+	return nil
+}
@@ -0,0 +1,266 @@
+package builders
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/interactors"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testGuardian = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+
+func createFrontendTransactionNetworkConfig() *data.NetworkConfig {
+	config := createTokenTransferNetworkConfig()
+	config.ChainID = "T"
+	config.MinGasPrice = 1000000000
+	config.MinTransactionVersion = 1
+	config.ExtraGasLimitGuardedTx = 50000
+
+	return config
+}
+
+func createFrontendTransactionBuilder() *frontendTransactionBuilder {
+	return NewFrontendTransactionBuilder().
+		SetNetworkConfig(createFrontendTransactionNetworkConfig()).
+		SetSender(&data.Account{Address: testTokenSender, Nonce: 5}).
+		SetReceiver(testTokenReceiver)
+}
+
+func TestFrontendTransactionBuilder_BuildErrors(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("nil network config should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createFrontendTransactionBuilder().SetNetworkConfig(nil).Build(ctx)
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNilNetworkConfig, err)
+	})
+	t.Run("missing chain ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		config := createFrontendTransactionNetworkConfig()
+		config.ChainID = ""
+		tx, err := createFrontendTransactionBuilder().SetNetworkConfig(config).Build(ctx)
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrMissingChainID, err)
+	})
+	t.Run("nil sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createFrontendTransactionBuilder().SetSender(nil).Build(ctx)
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNilSenderAccount, err)
+	})
+	t.Run("invalid receiver should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createFrontendTransactionBuilder().SetReceiver("erd1invalid").Build(ctx)
+		assert.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrInvalidAddress)
+		assert.Contains(t, err.Error(), "receiver")
+	})
+	t.Run("invalid relayer should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createFrontendTransactionBuilder().SetRelayer("erd1invalid").Build(ctx)
+		assert.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrInvalidAddress)
+		assert.Contains(t, err.Error(), "relayer")
+	})
+	t.Run("guarded option without guardian should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createFrontendTransactionBuilder().SetOptions(transaction.MaskGuardedTransaction).Build(ctx)
+		assert.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrInvalidTransactionOptions)
+	})
+	t.Run("invalid guardian should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createFrontendTransactionBuilder().SetGuardian("guardian").Build(ctx)
+		assert.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrInvalidAddress)
+	})
+	t.Run("unknown options should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createFrontendTransactionBuilder().SetOptions(1 << 5).Build(ctx)
+		assert.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrInvalidTransactionOptions)
+	})
+	t.Run("negative value should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createFrontendTransactionBuilder().SetValue(big.NewInt(-1)).Build(ctx)
+		assert.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrInvalidValue)
+	})
+	t.Run("ESDT amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		amount, _ := data.ParseAmount("1 USDC-c76f1f", 6)
+		tx, err := createFrontendTransactionBuilder().SetAmount(amount).Build(ctx)
+		assert.Nil(t, tx)
+		assert.ErrorIs(t, err, data.ErrIncompatibleAmounts)
+	})
+	t.Run("data builder error should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createFrontendTransactionBuilder().SetData(NewTxDataBuilder().Function("f").ArgBigInt(nil)).Build(ctx)
+		assert.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrNilValue)
+	})
+	t.Run("data too large should error", func(t *testing.T) {
+		t.Parallel()
+
+		dataBuilder := NewTxDataBuilder().Function(strings.Repeat("a", maxTransactionDataSize+1))
+		tx, err := createFrontendTransactionBuilder().SetData(dataBuilder).Build(ctx)
+		assert.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrDataTooLarge)
+	})
+	t.Run("gas price under the minimum should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createFrontendTransactionBuilder().SetGasPrice(10).Build(ctx)
+		assert.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrInvalidGasPrice)
+	})
+	t.Run("gas limit under the move balance cost should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createFrontendTransactionBuilder().SetGuardian(testGuardian).SetGasLimit(50000).Build(ctx)
+		assert.Nil(t, tx)
+		assert.ErrorIs(t, err, data.ErrInsufficientGasLimit)
+	})
+	t.Run("gas estimator error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		estimator, _ := interactors.NewGasEstimator(interactors.ArgsGasEstimator{
+			NetworkConfig: createFrontendTransactionNetworkConfig(),
+			CostRequester: &testsCommon.ProxyStub{
+				RequestTransactionCostCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+					return nil, expectedErr
+				},
+			},
+		})
+		tx, err := createFrontendTransactionBuilder().
+			SetData(NewTxDataBuilder().Function("add").ArgInt64(1)).
+			SetGasEstimator(estimator).
+			Build(ctx)
+		assert.Nil(t, tx)
+		assert.Equal(t, expectedErr, err)
+	})
+}
+
+func TestFrontendTransactionBuilder_Build(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+
+	t.Run("move balance should work", func(t *testing.T) {
+		t.Parallel()
+
+		amount, _ := data.ParseEGLDAmount("1.5 EGLD")
+		tx, err := createFrontendTransactionBuilder().SetAmount(amount).Build(ctx)
+		require.Nil(t, err)
+
+		expectedTx := &transaction.FrontendTransaction{
+			Nonce:    5,
+			Value:    "1500000000000000000",
+			Receiver: testTokenReceiver,
+			Sender:   testTokenSender,
+			GasPrice: 1000000000,
+			GasLimit: 50000,
+			ChainID:  "T",
+			Version:  1,
+		}
+		assert.Equal(t, expectedTx, tx)
+	})
+	t.Run("custom address converter should work", func(t *testing.T) {
+		t.Parallel()
+
+		converter := createTestAddressConverter(t)
+		sender := convertTestAddress(t, testTokenSender, converter)
+		receiver := convertTestAddress(t, testTokenReceiver, converter)
+
+		tx, err := createFrontendTransactionBuilder().
+			SetSender(&data.Account{Address: sender, Nonce: 5}).
+			SetReceiver(receiver).
+			Build(ctx)
+		assert.Nil(t, tx)
+		assert.NotNil(t, err)
+
+		tx, err = createFrontendTransactionBuilder().
+			SetSender(&data.Account{Address: sender, Nonce: 5}).
+			SetReceiver(receiver).
+			SetAddressConverter(converter).
+			Build(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, sender, tx.Sender)
+		assert.Equal(t, receiver, tx.Receiver)
+	})
+	t.Run("guarded, hash signed and relayed transaction should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := createFrontendTransactionBuilder().
+			SetNonce(8).
+			SetValue(big.NewInt(10)).
+			SetData(NewTxDataBuilder().Function("note")).
+			SetGasPrice(1500000000).
+			SetGuardian(testGuardian).
+			SetSignWithHash().
+			SetRelayer(testGuardian).
+			Build(ctx)
+		require.Nil(t, err)
+
+		assert.Equal(t, uint64(8), tx.Nonce)
+		assert.Equal(t, "10", tx.Value)
+		assert.Equal(t, []byte("note"), tx.Data)
+		assert.Equal(t, uint64(1500000000), tx.GasPrice)
+		assert.Equal(t, uint32(2), tx.Version)
+		assert.Equal(t, transaction.MaskGuardedTransaction|transaction.MaskSignedWithHash, tx.Options)
+		assert.Equal(t, testGuardian, tx.GuardianAddr)
+		assert.Equal(t, testGuardian, tx.RelayerAddr)
+		assert.Equal(t, uint64(50000+1500*4+50000+50000), tx.GasLimit)
+	})
+	t.Run("gas estimator should be used for contract calls", func(t *testing.T) {
+		t.Parallel()
+
+		estimator, _ := interactors.NewGasEstimator(interactors.ArgsGasEstimator{
+			NetworkConfig: createFrontendTransactionNetworkConfig(),
+			CostRequester: &testsCommon.ProxyStub{
+				RequestTransactionCostCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+					assert.Equal(t, "add@01", string(tx.Data))
+					return &data.TxCostResponseData{TxCost: 2000000}, nil
+				},
+			},
+			SafetyMarginPercent: interactors.DefaultGasSafetyMarginPercent,
+		})
+		tx, err := createFrontendTransactionBuilder().
+			SetData(NewTxDataBuilder().Function("add").ArgInt64(1)).
+			SetGasEstimator(estimator).
+			Build(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(2200000), tx.GasLimit)
+
+		tx, err = createFrontendTransactionBuilder().
+			SetData(NewTxDataBuilder().Function("add").ArgInt64(1)).
+			SetGasEstimator(estimator).
+			SetGasLimit(3000000).
+			Build(ctx)
+		require.Nil(t, err)
+		assert.Equal(t, uint64(3000000), tx.GasLimit)
+	})
+}
@@ -0,0 +1,187 @@
+package builders
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	// GovernanceSCAddress is the bech32 address of the governance system smart contract
+	GovernanceSCAddress = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqrlllsrujgla"
+
+	gasLimitProposal      = 50000000
+	gasLimitVote          = 5000000
+	gasLimitCloseProposal = 50000000
+)
+
+// VoteType is the vote option used on governance proposals
+type VoteType string
+
+const (
+	// VoteYes is the vote in favour of the proposal
+	VoteYes VoteType = "yes"
+	// VoteNo is the vote against the proposal
+	VoteNo VoteType = "no"
+	// VoteAbstain is the vote that only counts towards the quorum
+	VoteAbstain VoteType = "abstain"
+	// VoteVeto is the vote against the proposal that also makes the issuer lose the proposal fee
+	VoteVeto VoteType = "veto"
+)
+
+// ProposalArgs is the argument DTO used to create a new governance proposal
+type ProposalArgs struct {
+	CommitHash     string
+	StartVoteEpoch uint64
+	EndVoteEpoch   uint64
+}
+
+type governanceBuilder struct {
+	networkConfig       *data.NetworkConfig
+	addressConverter    core.AddressConverter
+	governanceSCAddress string
+}
+
+// NewGovernanceBuilder creates a new builder able to generate the governance system smart contract transactions
+func NewGovernanceBuilder(networkConfig *data.NetworkConfig) (*governanceBuilder, error) {
+	return NewGovernanceBuilderWithAddressConverter(networkConfig, core.AddressPublicKeyConverter)
+}
+
+// NewGovernanceBuilderWithAddressConverter creates a new governance builder that decodes the bech32 addresses and
+// encodes the governance system smart contract address using the provided address converter, for the chains that do
+// not use the default address prefix. A nil converter means the default one
+func NewGovernanceBuilderWithAddressConverter(
+	networkConfig *data.NetworkConfig,
+	addressConverter core.AddressConverter,
+) (*governanceBuilder, error) {
+	if networkConfig == nil {
+		return nil, ErrNilNetworkConfig
+	}
+
+	addressConverter = core.AddressConverterOrDefault(addressConverter)
+	governanceSCAddress, err := encodeSystemAddress(GovernanceSCAddress, addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &governanceBuilder{
+		networkConfig:       networkConfig,
+		addressConverter:    addressConverter,
+		governanceSCAddress: governanceSCAddress,
+	}, nil
+}
+
+// Proposal builds the proposal transaction. The transferred value is the proposal fee from the governance config
+func (builder *governanceBuilder) Proposal(
+	sender *data.Account,
+	governanceConfig *data.GovernanceConfig,
+	args ProposalArgs,
+) (*transaction.FrontendTransaction, error) {
+	if governanceConfig == nil || governanceConfig.ProposalFee == nil {
+		return nil, ErrNilGovernanceConfig
+	}
+	if len(args.CommitHash) == 0 {
+		return nil, ErrEmptyCommitHash
+	}
+	if args.StartVoteEpoch >= args.EndVoteEpoch {
+		return nil, fmt.Errorf("%w, start epoch %d, end epoch %d", ErrInvalidVotingPeriod, args.StartVoteEpoch, args.EndVoteEpoch)
+	}
+
+	dataBuilder := NewTxDataBuilder().
+		Function("proposal").
+		ArgBytes([]byte(args.CommitHash)).
+		ArgBigInt(big.NewInt(0).SetUint64(args.StartVoteEpoch)).
+		ArgBigInt(big.NewInt(0).SetUint64(args.EndVoteEpoch))
+
+	return builder.createTransaction(sender, governanceConfig.ProposalFee, dataBuilder, gasLimitProposal)
+}
+
+// Vote builds the vote transaction for the provided proposal
+func (builder *governanceBuilder) Vote(
+	sender *data.Account,
+	proposalNonce uint64,
+	vote VoteType,
+) (*transaction.FrontendTransaction, error) {
+	err := checkVoteType(vote)
+	if err != nil {
+		return nil, err
+	}
+
+	dataBuilder := NewTxDataBuilder().
+		Function("vote").
+		ArgBigInt(big.NewInt(0).SetUint64(proposalNonce)).
+		ArgBytes([]byte(vote))
+
+	return builder.createTransaction(sender, nil, dataBuilder, gasLimitVote)
+}
+
+// DelegateVote builds the delegateVote transaction, used by the contracts holding staked funds on behalf of the voter
+// (for example, the delegation contracts) to vote with the voter's balance
+func (builder *governanceBuilder) DelegateVote(
+	sender *data.Account,
+	proposalNonce uint64,
+	vote VoteType,
+	voter string,
+	balanceToVote *big.Int,
+) (*transaction.FrontendTransaction, error) {
+	err := checkVoteType(vote)
+	if err != nil {
+		return nil, err
+	}
+
+	voterAddress, err := data.NewAddressFromBech32StringWithConverter(voter, builder.addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	dataBuilder := NewTxDataBuilder().
+		Function("delegateVote").
+		ArgBigInt(big.NewInt(0).SetUint64(proposalNonce)).
+		ArgBytes([]byte(vote)).
+		ArgAddress(voterAddress).
+		ArgBigInt(balanceToVote)
+
+	return builder.createTransaction(sender, nil, dataBuilder, gasLimitVote)
+}
+
+// CloseProposal builds the closeProposal transaction. Can be called by the proposal issuer after the voting period ended
+func (builder *governanceBuilder) CloseProposal(sender *data.Account, proposalNonce uint64) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().
+		Function("closeProposal").
+		ArgBigInt(big.NewInt(0).SetUint64(proposalNonce))
+
+	return builder.createTransaction(sender, nil, dataBuilder, gasLimitCloseProposal)
+}
+
+func (builder *governanceBuilder) createTransaction(
+	sender *data.Account,
+	value *big.Int,
+	dataBuilder TxDataBuilder,
+	executionGas uint64,
+) (*transaction.FrontendTransaction, error) {
+	return createSCCallTransaction(argsSCCallTransaction{
+		networkConfig: builder.networkConfig,
+		sender:        sender,
+		receiver:      builder.governanceSCAddress,
+		value:         value,
+		dataBuilder:   dataBuilder,
+		executionGas:  executionGas,
+	})
+}
+
+func checkVoteType(vote VoteType) error {
+	switch vote {
+	case VoteYes, VoteNo, VoteAbstain, VoteVeto:
+		return nil
+	default:
+		return fmt.Errorf("%w: %s", ErrInvalidVoteType, vote)
+	}
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (builder *governanceBuilder) IsInterfaceNil() bool {
+	return builder == nil
+}
@@ -0,0 +1,129 @@
+package builders
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGovernanceBuilder(t *testing.T) {
+	t.Parallel()
+
+	builder, err := NewGovernanceBuilder(nil)
+	assert.True(t, check.IfNil(builder))
+	assert.Equal(t, ErrNilNetworkConfig, err)
+
+	builder, err = NewGovernanceBuilder(createTokenTransferNetworkConfig())
+	assert.False(t, check.IfNil(builder))
+	assert.Nil(t, err)
+}
+
+func TestGovernanceBuilder_WithAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	converter := createTestAddressConverter(t)
+	builder, err := NewGovernanceBuilderWithAddressConverter(nil, converter)
+	assert.True(t, check.IfNil(builder))
+	assert.Equal(t, ErrNilNetworkConfig, err)
+
+	builder, err = NewGovernanceBuilderWithAddressConverter(createTokenTransferNetworkConfig(), converter)
+	require.Nil(t, err)
+
+	sender := &data.Account{Address: convertTestAddress(t, testTokenSender, converter), Nonce: 7}
+	tx, err := builder.CloseProposal(sender, 1)
+	require.Nil(t, err)
+	assert.Equal(t, convertTestAddress(t, GovernanceSCAddress, converter), tx.Receiver)
+}
+
+func TestGovernanceBuilder_Proposal(t *testing.T) {
+	t.Parallel()
+
+	sender := &data.Account{Address: testTokenSender, Nonce: 7}
+	builder, _ := NewGovernanceBuilder(createTokenTransferNetworkConfig())
+	governanceConfig := &data.GovernanceConfig{ProposalFee: big.NewInt(1000)}
+	args := ProposalArgs{
+		CommitHash:     "abc",
+		StartVoteEpoch: 10,
+		EndVoteEpoch:   20,
+	}
+
+	t.Run("nil governance config should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Proposal(sender, nil, args)
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNilGovernanceConfig, err)
+	})
+	t.Run("empty commit hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Proposal(sender, governanceConfig, ProposalArgs{StartVoteEpoch: 1, EndVoteEpoch: 2})
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrEmptyCommitHash, err)
+	})
+	t.Run("invalid voting period should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Proposal(sender, governanceConfig, ProposalArgs{CommitHash: "abc", StartVoteEpoch: 2, EndVoteEpoch: 2})
+		assert.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrInvalidVotingPeriod)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Proposal(sender, governanceConfig, args)
+		require.Nil(t, err)
+
+		expectedData := "proposal@616263@0a@14"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, GovernanceSCAddress, tx.Receiver)
+		assert.Equal(t, "1000", tx.Value)
+		assert.Equal(t, uint64(50000+1500*len(expectedData)+50000000), tx.GasLimit)
+	})
+}
+
+func TestGovernanceBuilder_Vote(t *testing.T) {
+	t.Parallel()
+
+	sender := &data.Account{Address: testTokenSender, Nonce: 7}
+	builder, _ := NewGovernanceBuilder(createTokenTransferNetworkConfig())
+
+	t.Run("invalid vote should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Vote(sender, 1, "maybe")
+		assert.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrInvalidVoteType)
+
+		tx, err = builder.DelegateVote(sender, 1, "maybe", testTokenReceiver, big.NewInt(1))
+		assert.Nil(t, tx)
+		assert.ErrorIs(t, err, ErrInvalidVoteType)
+	})
+	t.Run("vote should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Vote(sender, 1, VoteVeto)
+		require.Nil(t, err)
+		assert.Equal(t, "vote@01@7665746f", string(tx.Data))
+		assert.Equal(t, "0", tx.Value)
+	})
+	t.Run("delegateVote should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.DelegateVote(sender, 2, VoteYes, testTokenReceiver, big.NewInt(100))
+		require.Nil(t, err)
+		assert.Equal(t, "delegateVote@02@796573@000000000000000005004888d06daef6d4ce8a01d72812d08617b4b504a369e1@64", string(tx.Data))
+	})
+	t.Run("closeProposal should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.CloseProposal(sender, 3)
+		require.Nil(t, err)
+		assert.Equal(t, "closeProposal@03", string(tx.Data))
+		assert.Equal(t, GovernanceSCAddress, tx.Receiver)
+	})
+}
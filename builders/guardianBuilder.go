@@ -0,0 +1,147 @@
+package builders
+
+import (
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	gasLimitGuardianOperation = 250000
+
+	minTransactionVersionForGuardedTx = 2
+)
+
+type guardianBuilder struct {
+	networkConfig    *data.NetworkConfig
+	addressConverter sdkCore.AddressConverter
+}
+
+// NewGuardianBuilder creates a new builder able to generate the guardian related built-in function transactions
+func NewGuardianBuilder(networkConfig *data.NetworkConfig) (*guardianBuilder, error) {
+	return NewGuardianBuilderWithAddressConverter(networkConfig, sdkCore.AddressPublicKeyConverter)
+}
+
+// NewGuardianBuilderWithAddressConverter creates a new guardian builder that decodes the guardian addresses using the
+// provided address converter, for the chains that do not use the default address prefix. A nil converter means the
+// default one
+func NewGuardianBuilderWithAddressConverter(
+	networkConfig *data.NetworkConfig,
+	addressConverter sdkCore.AddressConverter,
+) (*guardianBuilder, error) {
+	if networkConfig == nil {
+		return nil, ErrNilNetworkConfig
+	}
+
+	return &guardianBuilder{
+		networkConfig:    networkConfig,
+		addressConverter: sdkCore.AddressConverterOrDefault(addressConverter),
+	}, nil
+}
+
+// SetGuardian builds the SetGuardian transaction. The guardian will become active after the activation epoch,
+// as reported by the guardian data of the account
+func (builder *guardianBuilder) SetGuardian(
+	sender *data.Account,
+	guardian string,
+	serviceUID string,
+) (*transaction.FrontendTransaction, error) {
+	if len(serviceUID) == 0 {
+		return nil, ErrEmptyServiceUID
+	}
+
+	guardianAddress, err := data.NewAddressFromBech32StringWithConverter(guardian, builder.addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	dataBuilder := NewTxDataBuilder().
+		Function(core.BuiltInFunctionSetGuardian).
+		ArgAddress(guardianAddress).
+		ArgBytes([]byte(serviceUID))
+
+	return builder.createTransaction(sender, dataBuilder)
+}
+
+// GuardAccount builds the GuardAccount transaction. The account should have an active guardian
+func (builder *guardianBuilder) GuardAccount(sender *data.Account) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().Function(core.BuiltInFunctionGuardAccount)
+
+	return builder.createTransaction(sender, dataBuilder)
+}
+
+// UnGuardAccount builds the UnGuardAccount transaction. As the account is guarded, the transaction should also be
+// co-signed by the active guardian (see ApplyGuardedTransactionOptions)
+func (builder *guardianBuilder) UnGuardAccount(sender *data.Account) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().Function(core.BuiltInFunctionUnGuardAccount)
+
+	return builder.createTransaction(sender, dataBuilder)
+}
+
+// the guardian built-in functions are executed on the sender's account, so the sender is also the receiver
+func (builder *guardianBuilder) createTransaction(
+	sender *data.Account,
+	dataBuilder TxDataBuilder,
+) (*transaction.FrontendTransaction, error) {
+	if sender == nil {
+		return nil, ErrNilSenderAccount
+	}
+
+	return createSCCallTransaction(argsSCCallTransaction{
+		networkConfig: builder.networkConfig,
+		sender:        sender,
+		receiver:      sender.Address,
+		dataBuilder:   dataBuilder,
+		executionGas:  gasLimitGuardianOperation,
+	})
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (builder *guardianBuilder) IsInterfaceNil() bool {
+	return builder == nil
+}
+
+// ApplyGuardedTransactionOptions prepares the unsigned transaction to be co-signed by the provided guardian: sets the
+// guardian address, the guarded option, the minimum version that supports options and adds the guarded transaction
+// extra gas limit. Calling it on an already guarded transaction will not add the extra gas limit again
+func ApplyGuardedTransactionOptions(
+	tx *transaction.FrontendTransaction,
+	guardian string,
+	networkConfig *data.NetworkConfig,
+) error {
+	return ApplyGuardedTransactionOptionsWithAddressConverter(tx, guardian, networkConfig, sdkCore.AddressPublicKeyConverter)
+}
+
+// ApplyGuardedTransactionOptionsWithAddressConverter is the ApplyGuardedTransactionOptions variant that decodes the
+// guardian address using the provided address converter. A nil converter means the default one
+func ApplyGuardedTransactionOptionsWithAddressConverter(
+	tx *transaction.FrontendTransaction,
+	guardian string,
+	networkConfig *data.NetworkConfig,
+	addressConverter sdkCore.AddressConverter,
+) error {
+	if tx == nil {
+		return ErrNilTransaction
+	}
+	if networkConfig == nil {
+		return ErrNilNetworkConfig
+	}
+
+	_, err := data.NewAddressFromBech32StringWithConverter(guardian, addressConverter)
+	if err != nil {
+		return err
+	}
+
+	if tx.Options&transaction.MaskGuardedTransaction == 0 {
+		tx.GasLimit += networkConfig.ExtraGasLimitGuardedTx
+	}
+
+	tx.GuardianAddr = guardian
+	tx.Options |= transaction.MaskGuardedTransaction
+	if tx.Version < minTransactionVersionForGuardedTx {
+		tx.Version = minTransactionVersionForGuardedTx
+	}
+
+	return nil
+}
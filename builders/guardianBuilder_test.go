@@ -0,0 +1,148 @@
+package builders
+
+import (
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewGuardianBuilder(t *testing.T) {
+	t.Parallel()
+
+	builder, err := NewGuardianBuilder(nil)
+	assert.True(t, check.IfNil(builder))
+	assert.Equal(t, ErrNilNetworkConfig, err)
+
+	builder, err = NewGuardianBuilder(createTokenTransferNetworkConfig())
+	assert.False(t, check.IfNil(builder))
+	assert.Nil(t, err)
+}
+
+func TestGuardianBuilder(t *testing.T) {
+	t.Parallel()
+
+	sender := &data.Account{Address: testTokenSender, Nonce: 4}
+	builder, _ := NewGuardianBuilder(createTokenTransferNetworkConfig())
+
+	t.Run("nil sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.GuardAccount(nil)
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNilSenderAccount, err)
+	})
+	t.Run("empty service UID should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.SetGuardian(sender, testTokenReceiver, "")
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrEmptyServiceUID, err)
+	})
+	t.Run("invalid guardian should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.SetGuardian(sender, "invalid", "uid")
+		assert.Nil(t, tx)
+		assert.NotNil(t, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.SetGuardian(sender, testTokenReceiver, "uid")
+		require.Nil(t, err)
+
+		expectedData := "SetGuardian@000000000000000005004888d06daef6d4ce8a01d72812d08617b4b504a369e1@756964"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, testTokenSender, tx.Receiver)
+		assert.Equal(t, uint64(4), tx.Nonce)
+		assert.Equal(t, uint64(50000+1500*len(expectedData)+250000), tx.GasLimit)
+
+		tx, err = builder.GuardAccount(sender)
+		require.Nil(t, err)
+		assert.Equal(t, "GuardAccount", string(tx.Data))
+
+		tx, err = builder.UnGuardAccount(sender)
+		require.Nil(t, err)
+		assert.Equal(t, "UnGuardAccount", string(tx.Data))
+	})
+}
+
+func TestGuardianBuilder_WithAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	converter := createTestAddressConverter(t)
+	builder, err := NewGuardianBuilderWithAddressConverter(nil, converter)
+	assert.True(t, check.IfNil(builder))
+	assert.Equal(t, ErrNilNetworkConfig, err)
+
+	builder, err = NewGuardianBuilderWithAddressConverter(createTokenTransferNetworkConfig(), converter)
+	require.Nil(t, err)
+
+	senderAddress := convertTestAddress(t, testTokenSender, converter)
+	sender := &data.Account{Address: senderAddress, Nonce: 4}
+
+	tx, err := builder.SetGuardian(sender, testTokenReceiver, "uid")
+	assert.Nil(t, tx)
+	assert.NotNil(t, err)
+
+	tx, err = builder.SetGuardian(sender, convertTestAddress(t, testTokenReceiver, converter), "uid")
+	require.Nil(t, err)
+	assert.Equal(t, "SetGuardian@000000000000000005004888d06daef6d4ce8a01d72812d08617b4b504a369e1@756964", string(tx.Data))
+	assert.Equal(t, senderAddress, tx.Receiver)
+}
+
+func TestApplyGuardedTransactionOptions(t *testing.T) {
+	t.Parallel()
+
+	networkConfig := createTokenTransferNetworkConfig()
+	networkConfig.ExtraGasLimitGuardedTx = 50000
+
+	err := ApplyGuardedTransactionOptions(nil, testTokenReceiver, networkConfig)
+	assert.Equal(t, ErrNilTransaction, err)
+
+	tx := &transaction.FrontendTransaction{
+		GasLimit: 100000,
+		Version:  1,
+		Options:  transaction.MaskSignedWithHash,
+	}
+	err = ApplyGuardedTransactionOptions(tx, testTokenReceiver, nil)
+	assert.Equal(t, ErrNilNetworkConfig, err)
+
+	err = ApplyGuardedTransactionOptions(tx, "invalid", networkConfig)
+	assert.NotNil(t, err)
+
+	err = ApplyGuardedTransactionOptions(tx, testTokenReceiver, networkConfig)
+	require.Nil(t, err)
+	assert.Equal(t, testTokenReceiver, tx.GuardianAddr)
+	assert.Equal(t, transaction.MaskSignedWithHash|transaction.MaskGuardedTransaction, tx.Options)
+	assert.Equal(t, uint32(2), tx.Version)
+	assert.Equal(t, uint64(150000), tx.GasLimit)
+
+	// applying twice should not add the extra gas limit again
+	err = ApplyGuardedTransactionOptions(tx, testTokenReceiver, networkConfig)
+	require.Nil(t, err)
+	assert.Equal(t, uint64(150000), tx.GasLimit)
+}
+
+func TestApplyGuardedTransactionOptionsWithAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	converter := createTestAddressConverter(t)
+	guardian := convertTestAddress(t, testTokenReceiver, converter)
+	tx := &transaction.FrontendTransaction{
+		GasLimit: 100000,
+		Version:  1,
+	}
+
+	err := ApplyGuardedTransactionOptionsWithAddressConverter(tx, testTokenReceiver, createTokenTransferNetworkConfig(), converter)
+	assert.NotNil(t, err)
+
+	err = ApplyGuardedTransactionOptionsWithAddressConverter(tx, guardian, createTokenTransferNetworkConfig(), converter)
+	require.Nil(t, err)
+	assert.Equal(t, guardian, tx.GuardianAddr)
+	assert.Equal(t, transaction.MaskGuardedTransaction, tx.Options)
+}
@@ -1,6 +1,7 @@
 package builders
 
 import (
+	"context"
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
@@ -52,3 +53,21 @@ type Signer interface {
 	VerifyByteSlice(msg []byte, publicKey crypto.PublicKey, sig []byte) error
 	IsInterfaceNil() bool
 }
+
+// AddressGenerator defines the component able to compute the address of a newly deployed smart contract
+type AddressGenerator interface {
+	ComputeWasmVMScAddress(address core.AddressHandler, nonce uint64) (core.AddressHandler, error)
+	IsInterfaceNil() bool
+}
+
+// ArgumentsEncoder defines the component able to encode typed arguments, for example as described by a contract ABI
+type ArgumentsEncoder interface {
+	EncodeConstructorArguments(args ...interface{}) ([][]byte, error)
+	IsInterfaceNil() bool
+}
+
+// GasEstimator defines the component able to estimate the gas limit of a transaction
+type GasEstimator interface {
+	EstimateGasLimit(ctx context.Context, tx *transaction.FrontendTransaction) (uint64, error)
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,193 @@
+package builders
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	// MaxRoyalties is the maximum royalties value that can be set on an NFT (10000 means 100%)
+	MaxRoyalties = 10000
+
+	gasLimitESDTNFTCreate         = 3000000
+	gasLimitESDTNFTUpdate         = 1000000
+	gasLimitESDTNFTStoragePerByte = 50000
+)
+
+// NFTCreateArgs is the argument DTO used to create a new NFT, SFT or meta ESDT
+type NFTCreateArgs struct {
+	TokenIdentifier string
+	InitialQuantity *big.Int
+	Name            string
+	Royalties       uint32
+	Hash            []byte
+	Attributes      []byte
+	URIs            []string
+}
+
+type nftBuilder struct {
+	networkConfig *data.NetworkConfig
+}
+
+// NewNFTBuilder creates a new builder able to generate the NFT/SFT lifecycle built-in function transactions
+func NewNFTBuilder(networkConfig *data.NetworkConfig) (*nftBuilder, error) {
+	if networkConfig == nil {
+		return nil, ErrNilNetworkConfig
+	}
+
+	return &nftBuilder{
+		networkConfig: networkConfig,
+	}, nil
+}
+
+// Create builds the ESDTNFTCreate transaction. The sender should hold the ESDTRoleNFTCreate role
+func (builder *nftBuilder) Create(sender *data.Account, args NFTCreateArgs) (*transaction.FrontendTransaction, error) {
+	if args.Royalties > MaxRoyalties {
+		return nil, fmt.Errorf("%w, royalties %d, maximum %d", ErrInvalidRoyalties, args.Royalties, MaxRoyalties)
+	}
+
+	dataBuilder := NewTxDataBuilder().
+		Function(core.BuiltInFunctionESDTNFTCreate).
+		ArgBytes([]byte(args.TokenIdentifier)).
+		ArgBigInt(args.InitialQuantity).
+		ArgHexString(hex.EncodeToString([]byte(args.Name))).
+		ArgInt64(int64(args.Royalties)).
+		ArgHexString(hex.EncodeToString(args.Hash)).
+		ArgHexString(hex.EncodeToString(args.Attributes))
+
+	storedBytes := len(args.Name) + len(args.Hash) + len(args.Attributes)
+	for _, uri := range args.URIs {
+		dataBuilder.ArgBytes([]byte(uri))
+		storedBytes += len(uri)
+	}
+
+	executionGas := gasLimitESDTNFTCreate + uint64(storedBytes)*gasLimitESDTNFTStoragePerByte
+
+	return builder.createTransaction(sender, dataBuilder, executionGas)
+}
+
+// AddQuantity builds the ESDTNFTAddQuantity transaction that mints more units of an existing SFT or meta ESDT
+func (builder *nftBuilder) AddQuantity(
+	sender *data.Account,
+	tokenIdentifier string,
+	nonce uint64,
+	quantity *big.Int,
+) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().
+		Function(core.BuiltInFunctionESDTNFTAddQuantity).
+		ArgBytes([]byte(tokenIdentifier)).
+		ArgBigInt(big.NewInt(0).SetUint64(nonce)).
+		ArgBigInt(quantity)
+
+	return builder.createTransaction(sender, dataBuilder, gasLimitESDTNFTUpdate)
+}
+
+// Burn builds the ESDTNFTBurn transaction that burns units of an existing NFT, SFT or meta ESDT
+func (builder *nftBuilder) Burn(
+	sender *data.Account,
+	tokenIdentifier string,
+	nonce uint64,
+	quantity *big.Int,
+) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().
+		Function(core.BuiltInFunctionESDTNFTBurn).
+		ArgBytes([]byte(tokenIdentifier)).
+		ArgBigInt(big.NewInt(0).SetUint64(nonce)).
+		ArgBigInt(quantity)
+
+	return builder.createTransaction(sender, dataBuilder, gasLimitESDTNFTUpdate)
+}
+
+// UpdateAttributes builds the ESDTNFTUpdateAttributes transaction that replaces the attributes of an existing NFT
+func (builder *nftBuilder) UpdateAttributes(
+	sender *data.Account,
+	tokenIdentifier string,
+	nonce uint64,
+	attributes []byte,
+) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().
+		Function(core.BuiltInFunctionESDTNFTUpdateAttributes).
+		ArgBytes([]byte(tokenIdentifier)).
+		ArgBigInt(big.NewInt(0).SetUint64(nonce)).
+		ArgHexString(hex.EncodeToString(attributes))
+
+	executionGas := gasLimitESDTNFTUpdate + uint64(len(attributes))*gasLimitESDTNFTStoragePerByte
+
+	return builder.createTransaction(sender, dataBuilder, executionGas)
+}
+
+// AddURIs builds the ESDTNFTAddURI transaction that appends the provided URIs to an existing NFT
+func (builder *nftBuilder) AddURIs(
+	sender *data.Account,
+	tokenIdentifier string,
+	nonce uint64,
+	uris ...string,
+) (*transaction.FrontendTransaction, error) {
+	if len(uris) == 0 {
+		return nil, ErrNoURIsProvided
+	}
+
+	dataBuilder := NewTxDataBuilder().
+		Function(core.BuiltInFunctionESDTNFTAddURI).
+		ArgBytes([]byte(tokenIdentifier)).
+		ArgBigInt(big.NewInt(0).SetUint64(nonce))
+
+	storedBytes := 0
+	for _, uri := range uris {
+		dataBuilder.ArgBytes([]byte(uri))
+		storedBytes += len(uri)
+	}
+
+	executionGas := gasLimitESDTNFTUpdate + uint64(storedBytes)*gasLimitESDTNFTStoragePerByte
+
+	return builder.createTransaction(sender, dataBuilder, executionGas)
+}
+
+// ModifyRoyalties builds the ESDTModifyRoyalties transaction that changes the royalties of an existing NFT
+func (builder *nftBuilder) ModifyRoyalties(
+	sender *data.Account,
+	tokenIdentifier string,
+	nonce uint64,
+	royalties uint32,
+) (*transaction.FrontendTransaction, error) {
+	if royalties > MaxRoyalties {
+		return nil, fmt.Errorf("%w, royalties %d, maximum %d", ErrInvalidRoyalties, royalties, MaxRoyalties)
+	}
+
+	dataBuilder := NewTxDataBuilder().
+		Function(core.ESDTModifyRoyalties).
+		ArgBytes([]byte(tokenIdentifier)).
+		ArgBigInt(big.NewInt(0).SetUint64(nonce)).
+		ArgInt64(int64(royalties))
+
+	return builder.createTransaction(sender, dataBuilder, gasLimitESDTNFTUpdate)
+}
+
+// the NFT built-in functions are executed on the sender's account, so the sender is also the receiver
+func (builder *nftBuilder) createTransaction(
+	sender *data.Account,
+	dataBuilder TxDataBuilder,
+	executionGas uint64,
+) (*transaction.FrontendTransaction, error) {
+	if sender == nil {
+		return nil, ErrNilSenderAccount
+	}
+
+	return createSCCallTransaction(argsSCCallTransaction{
+		networkConfig: builder.networkConfig,
+		sender:        sender,
+		receiver:      sender.Address,
+		dataBuilder:   dataBuilder,
+		executionGas:  executionGas,
+	})
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (builder *nftBuilder) IsInterfaceNil() bool {
+	return builder == nil
+}
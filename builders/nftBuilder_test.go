@@ -0,0 +1,134 @@
+package builders
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewNFTBuilder(t *testing.T) {
+	t.Parallel()
+
+	builder, err := NewNFTBuilder(nil)
+	assert.True(t, check.IfNil(builder))
+	assert.Equal(t, ErrNilNetworkConfig, err)
+
+	builder, err = NewNFTBuilder(createTokenTransferNetworkConfig())
+	assert.False(t, check.IfNil(builder))
+	assert.Nil(t, err)
+}
+
+func TestNftBuilder_Create(t *testing.T) {
+	t.Parallel()
+
+	sender := &data.Account{
+		Address: testTokenSender,
+		Nonce:   1,
+	}
+	builder, _ := NewNFTBuilder(createTokenTransferNetworkConfig())
+
+	t.Run("invalid royalties should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Create(sender, NFTCreateArgs{
+			TokenIdentifier: "NFT-123456",
+			InitialQuantity: big.NewInt(1),
+			Royalties:       MaxRoyalties + 1,
+		})
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrInvalidRoyalties))
+	})
+	t.Run("nil sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Create(nil, NFTCreateArgs{
+			TokenIdentifier: "NFT-123456",
+			InitialQuantity: big.NewInt(1),
+		})
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNilSenderAccount, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Create(sender, NFTCreateArgs{
+			TokenIdentifier: "NFT-123456",
+			InitialQuantity: big.NewInt(1),
+			Name:            "test",
+			Royalties:       1000,
+			Hash:            nil,
+			Attributes:      []byte("tags:a"),
+			URIs:            []string{"uri"},
+		})
+		require.Nil(t, err)
+
+		expectedData := "ESDTNFTCreate@4e46542d313233343536@01@74657374@03e8@@746167733a61@757269"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, testTokenSender, tx.Receiver)
+		assert.Equal(t, testTokenSender, tx.Sender)
+		assert.Equal(t, "0", tx.Value)
+		assert.Equal(t, uint64(50000+1500*len(expectedData)+3000000+13*50000), tx.GasLimit)
+	})
+}
+
+func TestNftBuilder_Operations(t *testing.T) {
+	t.Parallel()
+
+	sender := &data.Account{
+		Address: testTokenSender,
+	}
+	builder, _ := NewNFTBuilder(createTokenTransferNetworkConfig())
+
+	t.Run("add quantity", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.AddQuantity(sender, "SFT-123456", 10, big.NewInt(5))
+		require.Nil(t, err)
+		assert.Equal(t, "ESDTNFTAddQuantity@5346542d313233343536@0a@05", string(tx.Data))
+		assert.Equal(t, testTokenSender, tx.Receiver)
+	})
+	t.Run("burn", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Burn(sender, "SFT-123456", 10, big.NewInt(5))
+		require.Nil(t, err)
+		assert.Equal(t, "ESDTNFTBurn@5346542d313233343536@0a@05", string(tx.Data))
+	})
+	t.Run("update attributes", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.UpdateAttributes(sender, "NFT-123456", 10, []byte("tags:b"))
+		require.Nil(t, err)
+		assert.Equal(t, "ESDTNFTUpdateAttributes@4e46542d313233343536@0a@746167733a62", string(tx.Data))
+	})
+	t.Run("add URIs without URIs should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.AddURIs(sender, "NFT-123456", 10)
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNoURIsProvided, err)
+	})
+	t.Run("add URIs", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.AddURIs(sender, "NFT-123456", 10, "a", "b")
+		require.Nil(t, err)
+		assert.Equal(t, "ESDTNFTAddURI@4e46542d313233343536@0a@61@62", string(tx.Data))
+	})
+	t.Run("modify royalties", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.ModifyRoyalties(sender, "NFT-123456", 10, 500)
+		require.Nil(t, err)
+		assert.Equal(t, "ESDTModifyRoyalties@4e46542d313233343536@0a@01f4", string(tx.Data))
+
+		tx, err = builder.ModifyRoyalties(sender, "NFT-123456", 10, MaxRoyalties+1)
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrInvalidRoyalties))
+	})
+}
@@ -14,6 +14,7 @@ type relayedTxV1Builder struct {
 	innerTransaction *transaction.FrontendTransaction
 	relayerAccount   *data.Account
 	networkConfig    *data.NetworkConfig
+	addressConverter core.AddressConverter
 }
 
 // NewRelayedTxV1Builder creates a new relayed transaction v1 builder
@@ -22,6 +23,7 @@ func NewRelayedTxV1Builder() *relayedTxV1Builder {
 		innerTransaction: nil,
 		relayerAccount:   nil,
 		networkConfig:    nil,
+		addressConverter: core.AddressPublicKeyConverter,
 	}
 }
 
@@ -46,6 +48,14 @@ func (rtb *relayedTxV1Builder) SetNetworkConfig(config *data.NetworkConfig) *rel
 	return rtb
 }
 
+// SetAddressConverter sets the address converter used to decode the inner transaction's addresses, for the chains
+// that do not use the default address prefix. A nil converter means the default one
+func (rtb *relayedTxV1Builder) SetAddressConverter(addressConverter core.AddressConverter) *relayedTxV1Builder {
+	rtb.addressConverter = core.AddressConverterOrDefault(addressConverter)
+
+	return rtb
+}
+
 // Build builds the relayed transaction v1
 // The returned transaction will not be signed
 func (rtb *relayedTxV1Builder) Build() (*transaction.FrontendTransaction, error) {
@@ -62,7 +72,7 @@ func (rtb *relayedTxV1Builder) Build() (*transaction.FrontendTransaction, error)
 		return nil, ErrNilNetworkConfig
 	}
 
-	innerTxHex, err := prepareInnerTxForRelayV1(rtb.innerTransaction)
+	innerTxHex, err := prepareInnerTxForRelayV1(rtb.innerTransaction, rtb.addressConverter)
 	if err != nil {
 		return nil, err
 	}
@@ -90,13 +100,12 @@ func (rtb *relayedTxV1Builder) Build() (*transaction.FrontendTransaction, error)
 	return relayedTx, nil
 }
 
-func prepareInnerTxForRelayV1(tx *transaction.FrontendTransaction) (string, error) {
+func prepareInnerTxForRelayV1(tx *transaction.FrontendTransaction, addressConverter core.AddressConverter) (string, error) {
 	txValue, ok := big.NewInt(0).SetString(tx.Value, 10)
 	if !ok {
 		return "", ErrInvalidValue
 	}
 
-	addressConverter := core.AddressPublicKeyConverter
 	receiverAddress, err := addressConverter.Decode(tx.Receiver)
 	if err != nil {
 		return "", err
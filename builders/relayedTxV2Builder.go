@@ -15,6 +15,7 @@ type relayedTxV2Builder struct {
 	gasLimitNeededForInnerTransaction uint64
 	relayerAccount                    *data.Account
 	networkConfig                     *data.NetworkConfig
+	addressConverter                  core.AddressConverter
 }
 
 // NewRelayedTxV2Builder creates a new relayed transaction v2 builder
@@ -23,6 +24,7 @@ func NewRelayedTxV2Builder() *relayedTxV2Builder {
 		innerTransaction: nil,
 		relayerAccount:   nil,
 		networkConfig:    nil,
+		addressConverter: core.AddressPublicKeyConverter,
 	}
 }
 
@@ -54,6 +56,14 @@ func (rtb *relayedTxV2Builder) SetNetworkConfig(config *data.NetworkConfig) *rel
 	return rtb
 }
 
+// SetAddressConverter sets the address converter used to decode the inner transaction's addresses, for the chains
+// that do not use the default address prefix. A nil converter means the default one
+func (rtb *relayedTxV2Builder) SetAddressConverter(addressConverter core.AddressConverter) *relayedTxV2Builder {
+	rtb.addressConverter = core.AddressConverterOrDefault(addressConverter)
+
+	return rtb
+}
+
 // Build builds the relayed transaction v1
 // The returned transaction will not be signed
 func (rtb *relayedTxV2Builder) Build() (*transaction.FrontendTransaction, error) {
@@ -76,7 +86,7 @@ func (rtb *relayedTxV2Builder) Build() (*transaction.FrontendTransaction, error)
 		return nil, ErrGasLimitForInnerTransactionV2ShouldBeZero
 	}
 
-	innerTxHex, err := prepareInnerTxForRelayV2(rtb.innerTransaction)
+	innerTxHex, err := prepareInnerTxForRelayV2(rtb.innerTransaction, rtb.addressConverter)
 	if err != nil {
 		return nil, err
 	}
@@ -99,9 +109,9 @@ func (rtb *relayedTxV2Builder) Build() (*transaction.FrontendTransaction, error)
 	return relayedTx, nil
 }
 
-func prepareInnerTxForRelayV2(tx *transaction.FrontendTransaction) (string, error) {
+func prepareInnerTxForRelayV2(tx *transaction.FrontendTransaction, addressConverter core.AddressConverter) (string, error) {
 	nonceBytes := big.NewInt(0).SetUint64(tx.Nonce).Bytes()
-	decodedReceiver, err := core.AddressPublicKeyConverter.Decode(tx.Receiver)
+	decodedReceiver, err := addressConverter.Decode(tx.Receiver)
 	if err != nil {
 		return "", err
 	}
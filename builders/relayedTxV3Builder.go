@@ -0,0 +1,135 @@
+package builders
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-go/sharding"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const minTransactionVersionForRelayedV3 = 2
+
+var relayedTxPrefixes = [][]byte{
+	[]byte("relayedTx@"),
+	[]byte("relayedTxV2@"),
+}
+
+type relayedTxV3Builder struct {
+	innerTransaction *transaction.FrontendTransaction
+	relayerAccount   *data.Account
+	networkConfig    *data.NetworkConfig
+	addressConverter core.AddressConverter
+}
+
+// NewRelayedTxV3Builder creates a new relayed transaction v3 builder
+func NewRelayedTxV3Builder() *relayedTxV3Builder {
+	return &relayedTxV3Builder{
+		innerTransaction: nil,
+		relayerAccount:   nil,
+		networkConfig:    nil,
+		addressConverter: core.AddressPublicKeyConverter,
+	}
+}
+
+// SetInnerTransaction sets the user transaction to be relayed. The transaction should not be signed yet as both the user
+// and the relayer have to sign the transaction after the relayer fields are set
+func (rtb *relayedTxV3Builder) SetInnerTransaction(tx *transaction.FrontendTransaction) *relayedTxV3Builder {
+	rtb.innerTransaction = tx
+
+	return rtb
+}
+
+// SetRelayerAccount sets the relayer account (that will pay the transaction fee)
+func (rtb *relayedTxV3Builder) SetRelayerAccount(account *data.Account) *relayedTxV3Builder {
+	rtb.relayerAccount = account
+
+	return rtb
+}
+
+// SetNetworkConfig sets the network config
+func (rtb *relayedTxV3Builder) SetNetworkConfig(config *data.NetworkConfig) *relayedTxV3Builder {
+	rtb.networkConfig = config
+
+	return rtb
+}
+
+// SetAddressConverter sets the address converter used to decode the bech32 addresses, for the chains that do not use
+// the default address prefix. A nil converter means the default one
+func (rtb *relayedTxV3Builder) SetAddressConverter(addressConverter core.AddressConverter) *relayedTxV3Builder {
+	rtb.addressConverter = core.AddressConverterOrDefault(addressConverter)
+
+	return rtb
+}
+
+// Build builds the relayed transaction v3 by setting the relayer address on a copy of the user transaction and adding the
+// extra gas consumed by the relaying. The relayer must be a different account from the sender, located in the sender's
+// shard. The returned transaction will not be signed, it should be signed by the user (ApplyUserSignature) and by the
+// relayer (ApplyRelayerSignature)
+func (rtb *relayedTxV3Builder) Build() (*transaction.FrontendTransaction, error) {
+	if rtb.innerTransaction == nil {
+		return nil, ErrNilInnerTransaction
+	}
+	if rtb.relayerAccount == nil {
+		return nil, ErrNilRelayerAccount
+	}
+	if rtb.networkConfig == nil {
+		return nil, ErrNilNetworkConfig
+	}
+	if isRelayedTxV1OrV2(rtb.innerTransaction.Data) {
+		return nil, ErrNestedRelayedTransaction
+	}
+
+	relayerAddress, err := data.NewAddressFromBech32StringWithConverter(rtb.relayerAccount.Address, rtb.addressConverter)
+	if err != nil {
+		return nil, err
+	}
+	senderAddress, err := data.NewAddressFromBech32StringWithConverter(rtb.innerTransaction.Sender, rtb.addressConverter)
+	if err != nil {
+		return nil, err
+	}
+	err = checkRelayerAddress(senderAddress.AddressBytes(), relayerAddress.AddressBytes(), rtb.networkConfig.NumShardsWithoutMeta)
+	if err != nil {
+		return nil, err
+	}
+
+	relayedTx := TransactionToUnsignedTx(rtb.innerTransaction)
+	relayedTx.RelayerAddr = rtb.relayerAccount.Address
+	relayedTx.GasLimit += rtb.networkConfig.MinGasLimit
+	if relayedTx.Version < minTransactionVersionForRelayedV3 {
+		relayedTx.Version = minTransactionVersionForRelayedV3
+	}
+
+	return relayedTx, nil
+}
+
+func checkRelayerAddress(senderAddress []byte, relayerAddress []byte, numShardsWithoutMeta uint32) error {
+	if bytes.Equal(senderAddress, relayerAddress) {
+		return ErrRelayerIsSender
+	}
+
+	shardCoordinator, err := sharding.NewMultiShardCoordinator(numShardsWithoutMeta, 0)
+	if err != nil {
+		return err
+	}
+
+	senderShard := shardCoordinator.ComputeId(senderAddress)
+	relayerShard := shardCoordinator.ComputeId(relayerAddress)
+	if senderShard != relayerShard {
+		return fmt.Errorf("%w: sender in shard %d, relayer in shard %d", ErrRelayerInDifferentShard, senderShard, relayerShard)
+	}
+
+	return nil
+}
+
+func isRelayedTxV1OrV2(txData []byte) bool {
+	for _, prefix := range relayedTxPrefixes {
+		if bytes.HasPrefix(txData, prefix) {
+			return true
+		}
+	}
+
+	return false
+}
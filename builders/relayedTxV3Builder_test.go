@@ -0,0 +1,203 @@
+package builders
+
+import (
+	"encoding/hex"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestRelayedTxV3Builder(t *testing.T) {
+	t.Parallel()
+
+	netConfig := &data.NetworkConfig{
+		ChainID:               "T",
+		MinTransactionVersion: 1,
+		GasPerDataByte:        1500,
+		MinGasLimit:           50000,
+		MinGasPrice:           1000000000,
+		NumShardsWithoutMeta:  3,
+	}
+
+	relayerAcc, relayerPrivKey := getAccount(t, testRelayerMnemonic)
+	innerSenderAcc, innerSenderPrivKey := getAccount(t, testInnerSenderMnemonic)
+
+	createInnerTx := func() *transaction.FrontendTransaction {
+		return &transaction.FrontendTransaction{
+			Nonce:    innerSenderAcc.Nonce,
+			Value:    "100000000",
+			Receiver: "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzllls8a5w6u",
+			Sender:   innerSenderAcc.Address,
+			GasPrice: netConfig.MinGasPrice,
+			GasLimit: 60000000,
+			Data:     []byte("getContractConfig"),
+			ChainID:  netConfig.ChainID,
+			Version:  netConfig.MinTransactionVersion,
+		}
+	}
+
+	t.Run("nil inner transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		relayedTx, err := NewRelayedTxV3Builder().
+			SetRelayerAccount(relayerAcc).
+			SetNetworkConfig(netConfig).
+			Build()
+		assert.Nil(t, relayedTx)
+		assert.Equal(t, ErrNilInnerTransaction, err)
+	})
+	t.Run("nil relayer account should error", func(t *testing.T) {
+		t.Parallel()
+
+		relayedTx, err := NewRelayedTxV3Builder().
+			SetInnerTransaction(createInnerTx()).
+			SetNetworkConfig(netConfig).
+			Build()
+		assert.Nil(t, relayedTx)
+		assert.Equal(t, ErrNilRelayerAccount, err)
+	})
+	t.Run("nil network config should error", func(t *testing.T) {
+		t.Parallel()
+
+		relayedTx, err := NewRelayedTxV3Builder().
+			SetInnerTransaction(createInnerTx()).
+			SetRelayerAccount(relayerAcc).
+			Build()
+		assert.Nil(t, relayedTx)
+		assert.Equal(t, ErrNilNetworkConfig, err)
+	})
+	t.Run("invalid relayer address should error", func(t *testing.T) {
+		t.Parallel()
+
+		relayedTx, err := NewRelayedTxV3Builder().
+			SetInnerTransaction(createInnerTx()).
+			SetRelayerAccount(&data.Account{Address: "invalid"}).
+			SetNetworkConfig(netConfig).
+			Build()
+		assert.Nil(t, relayedTx)
+		assert.NotNil(t, err)
+	})
+	t.Run("invalid sender address should error", func(t *testing.T) {
+		t.Parallel()
+
+		innerTx := createInnerTx()
+		innerTx.Sender = "invalid"
+		relayedTx, err := NewRelayedTxV3Builder().
+			SetInnerTransaction(innerTx).
+			SetRelayerAccount(relayerAcc).
+			SetNetworkConfig(netConfig).
+			Build()
+		assert.Nil(t, relayedTx)
+		assert.NotNil(t, err)
+	})
+	t.Run("relayer is the sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		relayedTx, err := NewRelayedTxV3Builder().
+			SetInnerTransaction(createInnerTx()).
+			SetRelayerAccount(&data.Account{Address: innerSenderAcc.Address}).
+			SetNetworkConfig(netConfig).
+			Build()
+		assert.Nil(t, relayedTx)
+		assert.Equal(t, ErrRelayerIsSender, err)
+	})
+	t.Run("relayer in a different shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		// the last byte of the address selects the shard, the sender is in shard 1
+		relayerBytes := make([]byte, 32)
+		relayerBytes[0] = 1
+		relayerAddress, _ := data.NewAddressFromBytes(relayerBytes).AddressAsBech32String()
+		relayedTx, err := NewRelayedTxV3Builder().
+			SetInnerTransaction(createInnerTx()).
+			SetRelayerAccount(&data.Account{Address: relayerAddress}).
+			SetNetworkConfig(netConfig).
+			Build()
+		assert.Nil(t, relayedTx)
+		assert.True(t, errors.Is(err, ErrRelayerInDifferentShard))
+	})
+	t.Run("nested relayed transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		innerTx := createInnerTx()
+		innerTx.Data = []byte("relayedTxV2@00@25@67")
+		relayedTx, err := NewRelayedTxV3Builder().
+			SetInnerTransaction(innerTx).
+			SetRelayerAccount(relayerAcc).
+			SetNetworkConfig(netConfig).
+			Build()
+		assert.Nil(t, relayedTx)
+		assert.Equal(t, ErrNestedRelayedTransaction, err)
+	})
+	t.Run("custom address converter should work", func(t *testing.T) {
+		t.Parallel()
+
+		converter := createTestAddressConverter(t)
+		innerTx := createInnerTx()
+		innerTx.Sender = convertTestAddress(t, innerSenderAcc.Address, converter)
+		relayerAddress := convertTestAddress(t, relayerAcc.Address, converter)
+
+		relayedTx, err := NewRelayedTxV3Builder().
+			SetInnerTransaction(innerTx).
+			SetRelayerAccount(&data.Account{Address: relayerAddress}).
+			SetNetworkConfig(netConfig).
+			Build()
+		assert.Nil(t, relayedTx)
+		assert.NotNil(t, err)
+
+		relayedTx, err = NewRelayedTxV3Builder().
+			SetInnerTransaction(innerTx).
+			SetRelayerAccount(&data.Account{Address: relayerAddress}).
+			SetNetworkConfig(netConfig).
+			SetAddressConverter(converter).
+			Build()
+		require.NoError(t, err)
+		assert.Equal(t, relayerAddress, relayedTx.RelayerAddr)
+		assert.Equal(t, innerTx.Sender, relayedTx.Sender)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		innerTx := createInnerTx()
+		innerTx.Signature = "previous signature"
+		relayedTx, err := NewRelayedTxV3Builder().
+			SetInnerTransaction(innerTx).
+			SetRelayerAccount(relayerAcc).
+			SetNetworkConfig(netConfig).
+			Build()
+		require.NoError(t, err)
+
+		assert.Equal(t, relayerAcc.Address, relayedTx.RelayerAddr)
+		assert.Equal(t, innerSenderAcc.Address, relayedTx.Sender)
+		assert.Equal(t, uint64(60000000+50000), relayedTx.GasLimit)
+		assert.Equal(t, uint32(2), relayedTx.Version)
+		assert.Empty(t, relayedTx.Signature)
+		assert.Equal(t, uint64(60000000), innerTx.GasLimit)
+		assert.Empty(t, innerTx.RelayerAddr)
+
+		keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
+		userCryptoHolder, err := cryptoProvider.NewCryptoComponentsHolder(keyGen, innerSenderPrivKey)
+		require.NoError(t, err)
+		relayerCryptoHolder, err := cryptoProvider.NewCryptoComponentsHolder(keyGen, relayerPrivKey)
+		require.NoError(t, err)
+
+		txBuilder, err := NewTxBuilder(cryptoProvider.NewSigner())
+		require.NoError(t, err)
+
+		err = txBuilder.ApplyUserSignature(userCryptoHolder, relayedTx)
+		require.NoError(t, err)
+		err = txBuilder.ApplyRelayerSignature(relayerCryptoHolder, relayedTx)
+		require.NoError(t, err)
+
+		unsignedTx := TransactionToUnsignedTx(relayedTx)
+		assert.Equal(t, hex.EncodeToString(signTx(t, innerSenderPrivKey, unsignedTx)), relayedTx.Signature)
+		assert.Equal(t, hex.EncodeToString(signTx(t, relayerPrivKey, unsignedTx)), relayedTx.RelayerSignature)
+	})
+}
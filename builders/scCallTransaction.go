@@ -0,0 +1,64 @@
+package builders
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+type argsSCCallTransaction struct {
+	networkConfig *data.NetworkConfig
+	sender        *data.Account
+	receiver      string
+	value         *big.Int
+	dataBuilder   TxDataBuilder
+	executionGas  uint64
+}
+
+// createSCCallTransaction assembles an unsigned transaction that calls a (system) smart contract or a built-in function.
+// The gas limit is computed as the move balance cost of the data field plus the provided execution gas
+func createSCCallTransaction(args argsSCCallTransaction) (*transaction.FrontendTransaction, error) {
+	if args.networkConfig == nil {
+		return nil, ErrNilNetworkConfig
+	}
+	if args.sender == nil {
+		return nil, ErrNilSenderAccount
+	}
+
+	payload, err := args.dataBuilder.ToDataBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	value := "0"
+	if args.value != nil {
+		value = args.value.String()
+	}
+
+	tx := &transaction.FrontendTransaction{
+		Nonce:    args.sender.Nonce,
+		Value:    value,
+		Receiver: args.receiver,
+		Sender:   args.sender.Address,
+		GasPrice: args.networkConfig.MinGasPrice,
+		Data:     payload,
+		ChainID:  args.networkConfig.ChainID,
+		Version:  args.networkConfig.MinTransactionVersion,
+	}
+	tx.GasLimit = args.networkConfig.ComputeMoveBalanceGasLimit(tx) + args.executionGas
+
+	return tx, nil
+}
+
+// encodeSystemAddress re-encodes an address defined with the default address prefix, such as a system smart contract
+// address, using the provided address converter
+func encodeSystemAddress(bech32Address string, addressConverter core.AddressConverter) (string, error) {
+	addressBytes, err := core.AddressPublicKeyConverter.Decode(bech32Address)
+	if err != nil {
+		return "", err
+	}
+
+	return addressConverter.Encode(addressBytes)
+}
@@ -0,0 +1,297 @@
+package builders
+
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	// ValidatorSCAddress is the bech32 address of the validator (staking) system smart contract
+	ValidatorSCAddress = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqplllst77y4l"
+	// DelegationManagerSCAddress is the bech32 address of the delegation manager system smart contract
+	DelegationManagerSCAddress = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqylllslmq6y6"
+
+	gasLimitStakingOperation              = 5000000
+	gasLimitPerValidatorNode              = 6000000
+	gasLimitCreateDelegationContract      = 50000000
+	gasLimitDelegationOperation           = 1000000
+	gasLimitAdditionalDelegationOperation = 10000000
+)
+
+// ValidatorNode holds a validator's BLS public key and the BLS signature proving the key ownership
+// (the signature over the staking owner's address)
+type ValidatorNode struct {
+	BLSKey    []byte
+	Signature []byte
+}
+
+type stakingBuilder struct {
+	networkConfig              *data.NetworkConfig
+	addressConverter           core.AddressConverter
+	validatorSCAddress         string
+	delegationManagerSCAddress string
+}
+
+// NewStakingBuilder creates a new builder able to generate the transactions handled by the validator, the delegation
+// manager and the delegation system smart contracts
+func NewStakingBuilder(networkConfig *data.NetworkConfig) (*stakingBuilder, error) {
+	return NewStakingBuilderWithAddressConverter(networkConfig, core.AddressPublicKeyConverter)
+}
+
+// NewStakingBuilderWithAddressConverter creates a new staking builder that decodes the bech32 addresses and encodes the
+// system smart contract addresses using the provided address converter, for the chains that do not use the default
+// address prefix. A nil converter means the default one
+func NewStakingBuilderWithAddressConverter(
+	networkConfig *data.NetworkConfig,
+	addressConverter core.AddressConverter,
+) (*stakingBuilder, error) {
+	if networkConfig == nil {
+		return nil, ErrNilNetworkConfig
+	}
+
+	addressConverter = core.AddressConverterOrDefault(addressConverter)
+	validatorSCAddress, err := encodeSystemAddress(ValidatorSCAddress, addressConverter)
+	if err != nil {
+		return nil, err
+	}
+	delegationManagerSCAddress, err := encodeSystemAddress(DelegationManagerSCAddress, addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return &stakingBuilder{
+		networkConfig:              networkConfig,
+		addressConverter:           addressConverter,
+		validatorSCAddress:         validatorSCAddress,
+		delegationManagerSCAddress: delegationManagerSCAddress,
+	}, nil
+}
+
+// Stake builds the stake transaction for the provided validator nodes. The value should be the total stake amount for
+// all nodes. The reward address is optional, if empty, the rewards will be sent to the sender
+func (builder *stakingBuilder) Stake(
+	sender *data.Account,
+	value *big.Int,
+	rewardAddress string,
+	nodes ...ValidatorNode,
+) (*transaction.FrontendTransaction, error) {
+	if len(nodes) == 0 {
+		return nil, ErrNoValidatorNodesProvided
+	}
+
+	dataBuilder := NewTxDataBuilder().
+		Function("stake").
+		ArgInt64(int64(len(nodes)))
+	for _, node := range nodes {
+		dataBuilder.ArgBytes(node.BLSKey).ArgBytes(node.Signature)
+	}
+
+	err := builder.addOptionalAddressArgument(dataBuilder, rewardAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	executionGas := gasLimitStakingOperation + uint64(len(nodes))*gasLimitPerValidatorNode
+
+	return builder.createTransaction(sender, builder.validatorSCAddress, value, dataBuilder, executionGas)
+}
+
+// UnStake builds the unStake transaction for the provided BLS keys
+func (builder *stakingBuilder) UnStake(sender *data.Account, blsKeys ...[]byte) (*transaction.FrontendTransaction, error) {
+	return builder.createBLSKeysTransaction(sender, builder.validatorSCAddress, "unStake", gasLimitStakingOperation, blsKeys)
+}
+
+// UnBond builds the unBond transaction for the provided BLS keys
+func (builder *stakingBuilder) UnBond(sender *data.Account, blsKeys ...[]byte) (*transaction.FrontendTransaction, error) {
+	return builder.createBLSKeysTransaction(sender, builder.validatorSCAddress, "unBond", gasLimitStakingOperation, blsKeys)
+}
+
+// CreateNewDelegationContract builds the createNewDelegationContract transaction. The total delegation cap set to 0
+// means an uncapped contract. The service fee is expressed in hundredths of percent (1000 means 10%)
+func (builder *stakingBuilder) CreateNewDelegationContract(
+	sender *data.Account,
+	value *big.Int,
+	totalDelegationCap *big.Int,
+	serviceFee uint64,
+) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().
+		Function("createNewDelegationContract").
+		ArgBigInt(totalDelegationCap).
+		ArgBigInt(big.NewInt(0).SetUint64(serviceFee))
+
+	executionGas := uint64(gasLimitCreateDelegationContract + gasLimitAdditionalDelegationOperation)
+
+	return builder.createTransaction(sender, builder.delegationManagerSCAddress, value, dataBuilder, executionGas)
+}
+
+// Delegate builds the delegate transaction that delegates the provided value to the delegation contract
+func (builder *stakingBuilder) Delegate(
+	sender *data.Account,
+	delegationContract string,
+	value *big.Int,
+) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().Function("delegate")
+
+	return builder.createDelegationTransaction(sender, delegationContract, value, dataBuilder)
+}
+
+// UnDelegate builds the unDelegate transaction that un-delegates the provided amount from the delegation contract
+func (builder *stakingBuilder) UnDelegate(
+	sender *data.Account,
+	delegationContract string,
+	amount *big.Int,
+) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().
+		Function("unDelegate").
+		ArgBigInt(amount)
+
+	return builder.createDelegationTransaction(sender, delegationContract, nil, dataBuilder)
+}
+
+// Withdraw builds the withdraw transaction that returns the un-delegated funds after the unbonding period passed
+func (builder *stakingBuilder) Withdraw(sender *data.Account, delegationContract string) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().Function("withdraw")
+
+	return builder.createDelegationTransaction(sender, delegationContract, nil, dataBuilder)
+}
+
+// ClaimRewards builds the claimRewards transaction
+func (builder *stakingBuilder) ClaimRewards(sender *data.Account, delegationContract string) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().Function("claimRewards")
+
+	return builder.createDelegationTransaction(sender, delegationContract, nil, dataBuilder)
+}
+
+// ReDelegateRewards builds the reDelegateRewards transaction
+func (builder *stakingBuilder) ReDelegateRewards(sender *data.Account, delegationContract string) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().Function("reDelegateRewards")
+
+	return builder.createDelegationTransaction(sender, delegationContract, nil, dataBuilder)
+}
+
+// ChangeServiceFee builds the changeServiceFee transaction. The service fee is expressed in hundredths of percent
+func (builder *stakingBuilder) ChangeServiceFee(
+	sender *data.Account,
+	delegationContract string,
+	serviceFee uint64,
+) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().
+		Function("changeServiceFee").
+		ArgBigInt(big.NewInt(0).SetUint64(serviceFee))
+
+	return builder.createDelegationTransaction(sender, delegationContract, nil, dataBuilder)
+}
+
+// AddNodes builds the addNodes transaction that registers the provided validator nodes on the delegation contract.
+// The nodes signatures should be computed over the delegation contract address
+func (builder *stakingBuilder) AddNodes(
+	sender *data.Account,
+	delegationContract string,
+	nodes ...ValidatorNode,
+) (*transaction.FrontendTransaction, error) {
+	if len(nodes) == 0 {
+		return nil, ErrNoValidatorNodesProvided
+	}
+
+	dataBuilder := NewTxDataBuilder().Function("addNodes")
+	for _, node := range nodes {
+		dataBuilder.ArgBytes(node.BLSKey).ArgBytes(node.Signature)
+	}
+
+	executionGas := gasLimitDelegationOperation + gasLimitAdditionalDelegationOperation +
+		uint64(len(nodes))*gasLimitPerValidatorNode
+
+	return builder.createTransaction(sender, delegationContract, nil, dataBuilder, executionGas)
+}
+
+// StakeNodes builds the stakeNodes transaction for the provided BLS keys, already added on the delegation contract
+func (builder *stakingBuilder) StakeNodes(
+	sender *data.Account,
+	delegationContract string,
+	blsKeys ...[]byte,
+) (*transaction.FrontendTransaction, error) {
+	return builder.createBLSKeysTransaction(
+		sender,
+		delegationContract,
+		"stakeNodes",
+		gasLimitDelegationOperation+gasLimitAdditionalDelegationOperation,
+		blsKeys,
+	)
+}
+
+func (builder *stakingBuilder) createBLSKeysTransaction(
+	sender *data.Account,
+	receiver string,
+	function string,
+	baseGas uint64,
+	blsKeys [][]byte,
+) (*transaction.FrontendTransaction, error) {
+	if len(blsKeys) == 0 {
+		return nil, ErrNoValidatorNodesProvided
+	}
+
+	dataBuilder := NewTxDataBuilder().Function(function)
+	for _, blsKey := range blsKeys {
+		dataBuilder.ArgBytes(blsKey)
+	}
+
+	executionGas := baseGas + uint64(len(blsKeys))*gasLimitPerValidatorNode
+
+	return builder.createTransaction(sender, receiver, nil, dataBuilder, executionGas)
+}
+
+func (builder *stakingBuilder) createDelegationTransaction(
+	sender *data.Account,
+	delegationContract string,
+	value *big.Int,
+	dataBuilder TxDataBuilder,
+) (*transaction.FrontendTransaction, error) {
+	executionGas := uint64(gasLimitDelegationOperation + gasLimitAdditionalDelegationOperation)
+
+	return builder.createTransaction(sender, delegationContract, value, dataBuilder, executionGas)
+}
+
+func (builder *stakingBuilder) createTransaction(
+	sender *data.Account,
+	receiver string,
+	value *big.Int,
+	dataBuilder TxDataBuilder,
+	executionGas uint64,
+) (*transaction.FrontendTransaction, error) {
+	_, err := data.NewAddressFromBech32StringWithConverter(receiver, builder.addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return createSCCallTransaction(argsSCCallTransaction{
+		networkConfig: builder.networkConfig,
+		sender:        sender,
+		receiver:      receiver,
+		value:         value,
+		dataBuilder:   dataBuilder,
+		executionGas:  executionGas,
+	})
+}
+
+func (builder *stakingBuilder) addOptionalAddressArgument(dataBuilder TxDataBuilder, bech32Address string) error {
+	if len(bech32Address) == 0 {
+		return nil
+	}
+
+	address, err := data.NewAddressFromBech32StringWithConverter(bech32Address, builder.addressConverter)
+	if err != nil {
+		return err
+	}
+
+	dataBuilder.ArgAddress(address)
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (builder *stakingBuilder) IsInterfaceNil() bool {
+	return builder == nil
+}
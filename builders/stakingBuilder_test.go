@@ -0,0 +1,173 @@
+package builders
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewStakingBuilder(t *testing.T) {
+	t.Parallel()
+
+	builder, err := NewStakingBuilder(nil)
+	assert.True(t, check.IfNil(builder))
+	assert.Equal(t, ErrNilNetworkConfig, err)
+
+	builder, err = NewStakingBuilder(createTokenTransferNetworkConfig())
+	assert.False(t, check.IfNil(builder))
+	assert.Nil(t, err)
+}
+
+func TestStakingBuilder_WithAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	converter := createTestAddressConverter(t)
+	builder, err := NewStakingBuilderWithAddressConverter(nil, converter)
+	assert.True(t, check.IfNil(builder))
+	assert.Equal(t, ErrNilNetworkConfig, err)
+
+	builder, err = NewStakingBuilderWithAddressConverter(createTokenTransferNetworkConfig(), converter)
+	require.Nil(t, err)
+
+	sender := &data.Account{Address: convertTestAddress(t, testTokenSender, converter), Nonce: 3}
+	nodes := []ValidatorNode{{BLSKey: []byte{0xaa}, Signature: []byte{0xbb}}}
+
+	tx, err := builder.Stake(sender, big.NewInt(10), testTokenReceiver, nodes...)
+	assert.Nil(t, tx)
+	assert.NotNil(t, err)
+
+	tx, err = builder.Stake(sender, big.NewInt(10), convertTestAddress(t, testTokenReceiver, converter), nodes...)
+	require.Nil(t, err)
+	assert.Equal(t, "stake@01@aa@bb@000000000000000005004888d06daef6d4ce8a01d72812d08617b4b504a369e1", string(tx.Data))
+	assert.Equal(t, convertTestAddress(t, ValidatorSCAddress, converter), tx.Receiver)
+}
+
+func TestStakingBuilder_ValidatorOperations(t *testing.T) {
+	t.Parallel()
+
+	sender := &data.Account{Address: testTokenSender, Nonce: 3}
+	builder, _ := NewStakingBuilder(createTokenTransferNetworkConfig())
+	nodes := []ValidatorNode{
+		{BLSKey: []byte{0xaa}, Signature: []byte{0xbb}},
+		{BLSKey: []byte{0xcc}, Signature: []byte{0xdd}},
+	}
+
+	t.Run("stake without nodes should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Stake(sender, big.NewInt(10), "")
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNoValidatorNodesProvided, err)
+	})
+	t.Run("stake with invalid reward address should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Stake(sender, big.NewInt(10), "invalid", nodes...)
+		assert.Nil(t, tx)
+		assert.NotNil(t, err)
+	})
+	t.Run("stake should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Stake(sender, big.NewInt(10), testTokenReceiver, nodes...)
+		require.Nil(t, err)
+
+		expectedData := "stake@02@aa@bb@cc@dd@000000000000000005004888d06daef6d4ce8a01d72812d08617b4b504a369e1"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, ValidatorSCAddress, tx.Receiver)
+		assert.Equal(t, "10", tx.Value)
+		assert.Equal(t, uint64(3), tx.Nonce)
+		assert.Equal(t, uint64(50000+1500*len(expectedData)+5000000+2*6000000), tx.GasLimit)
+	})
+	t.Run("unStake and unBond should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.UnStake(sender, []byte{0xaa}, []byte{0xcc})
+		require.Nil(t, err)
+		assert.Equal(t, "unStake@aa@cc", string(tx.Data))
+		assert.Equal(t, "0", tx.Value)
+
+		tx, err = builder.UnBond(sender, []byte{0xaa})
+		require.Nil(t, err)
+		assert.Equal(t, "unBond@aa", string(tx.Data))
+
+		tx, err = builder.UnBond(sender)
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNoValidatorNodesProvided, err)
+	})
+}
+
+func TestStakingBuilder_DelegationOperations(t *testing.T) {
+	t.Parallel()
+
+	sender := &data.Account{Address: testTokenSender, Nonce: 3}
+	builder, _ := NewStakingBuilder(createTokenTransferNetworkConfig())
+
+	t.Run("createNewDelegationContract should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.CreateNewDelegationContract(sender, big.NewInt(1250), big.NewInt(0), 1000)
+		require.Nil(t, err)
+		assert.Equal(t, "createNewDelegationContract@00@03e8", string(tx.Data))
+		assert.Equal(t, DelegationManagerSCAddress, tx.Receiver)
+		assert.Equal(t, "1250", tx.Value)
+	})
+	t.Run("invalid delegation contract should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.ClaimRewards(sender, "invalid")
+		assert.Nil(t, tx)
+		assert.NotNil(t, err)
+	})
+	t.Run("delegation contract calls should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Delegate(sender, testTokenReceiver, big.NewInt(100))
+		require.Nil(t, err)
+		assert.Equal(t, "delegate", string(tx.Data))
+		assert.Equal(t, testTokenReceiver, tx.Receiver)
+		assert.Equal(t, "100", tx.Value)
+		assert.Equal(t, uint64(50000+1500*len(tx.Data)+11000000), tx.GasLimit)
+
+		tx, err = builder.UnDelegate(sender, testTokenReceiver, big.NewInt(100))
+		require.Nil(t, err)
+		assert.Equal(t, "unDelegate@64", string(tx.Data))
+		assert.Equal(t, "0", tx.Value)
+
+		tx, err = builder.Withdraw(sender, testTokenReceiver)
+		require.Nil(t, err)
+		assert.Equal(t, "withdraw", string(tx.Data))
+
+		tx, err = builder.ClaimRewards(sender, testTokenReceiver)
+		require.Nil(t, err)
+		assert.Equal(t, "claimRewards", string(tx.Data))
+
+		tx, err = builder.ReDelegateRewards(sender, testTokenReceiver)
+		require.Nil(t, err)
+		assert.Equal(t, "reDelegateRewards", string(tx.Data))
+
+		tx, err = builder.ChangeServiceFee(sender, testTokenReceiver, 500)
+		require.Nil(t, err)
+		assert.Equal(t, "changeServiceFee@01f4", string(tx.Data))
+	})
+	t.Run("nodes operations should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.AddNodes(sender, testTokenReceiver)
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNoValidatorNodesProvided, err)
+
+		tx, err = builder.AddNodes(sender, testTokenReceiver, ValidatorNode{BLSKey: []byte{0xaa}, Signature: []byte{0xbb}})
+		require.Nil(t, err)
+		assert.Equal(t, "addNodes@aa@bb", string(tx.Data))
+		assert.Equal(t, uint64(50000+1500*len(tx.Data)+11000000+6000000), tx.GasLimit)
+
+		tx, err = builder.StakeNodes(sender, testTokenReceiver, []byte{0xaa})
+		require.Nil(t, err)
+		assert.Equal(t, "stakeNodes@aa", string(tx.Data))
+	})
+}
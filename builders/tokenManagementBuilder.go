@@ -0,0 +1,399 @@
+package builders
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	// ESDTSystemSCAddress is the bech32 address of the ESDT system smart contract
+	ESDTSystemSCAddress = "erd1qqqqqqqqqqqqqqqpqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqzllls8a5w6u"
+
+	// FungibleTokenType is the token type used when registering a fungible token with all roles
+	FungibleTokenType = "FNG"
+	// SemiFungibleTokenType is the token type used when registering a semi-fungible token with all roles
+	SemiFungibleTokenType = "SFT"
+	// NonFungibleTokenType is the token type used when registering a non-fungible token with all roles
+	NonFungibleTokenType = "NFT"
+	// MetaESDTTokenType is the token type used when registering a meta ESDT token with all roles
+	MetaESDTTokenType = "META"
+
+	gasLimitESDTSystemSCOperation    = 60000000
+	defaultESDTIssueCost             = "50000000000000000" // 0.05 EGLD
+	esdtContractConfigFunction       = "getContractConfig"
+	esdtContractConfigIssueCostIndex = 1
+)
+
+// TokenProperties holds the properties that can be set when issuing a token
+type TokenProperties struct {
+	CanFreeze                bool
+	CanWipe                  bool
+	CanPause                 bool
+	CanTransferNFTCreateRole bool
+	CanChangeOwner           bool
+	CanUpgrade               bool
+	CanAddSpecialRoles       bool
+}
+
+// TokenPropertyChange holds a token property name and its new value, used in a controlChanges call
+type TokenPropertyChange struct {
+	Name  string
+	Value bool
+}
+
+// IssueFungibleArgs is the argument DTO used to issue a fungible token
+type IssueFungibleArgs struct {
+	TokenName     string
+	TokenTicker   string
+	InitialSupply *big.Int
+	NumDecimals   uint32
+	Properties    TokenProperties
+}
+
+// IssueNonFungibleArgs is the argument DTO used to issue a semi-fungible or a non-fungible token
+type IssueNonFungibleArgs struct {
+	TokenName   string
+	TokenTicker string
+	Properties  TokenProperties
+}
+
+// RegisterMetaESDTArgs is the argument DTO used to register a meta ESDT token
+type RegisterMetaESDTArgs struct {
+	TokenName   string
+	TokenTicker string
+	NumDecimals uint32
+	Properties  TokenProperties
+}
+
+// RegisterAndSetAllRolesArgs is the argument DTO used to register a token and set all roles for the sender
+type RegisterAndSetAllRolesArgs struct {
+	TokenName   string
+	TokenTicker string
+	TokenType   string
+	NumDecimals uint32
+}
+
+type tokenManagementBuilder struct {
+	networkConfig       *data.NetworkConfig
+	issueCost           *big.Int
+	esdtSystemSCAddress string
+}
+
+// NewTokenManagementBuilder creates a new builder able to generate the ESDT system smart contract transactions
+func NewTokenManagementBuilder(networkConfig *data.NetworkConfig) (*tokenManagementBuilder, error) {
+	return NewTokenManagementBuilderWithAddressConverter(networkConfig, core.AddressPublicKeyConverter)
+}
+
+// NewTokenManagementBuilderWithAddressConverter creates a new token management builder that encodes the ESDT system
+// smart contract address using the provided address converter, for the chains that do not use the default address
+// prefix. A nil converter means the default one
+func NewTokenManagementBuilderWithAddressConverter(
+	networkConfig *data.NetworkConfig,
+	addressConverter core.AddressConverter,
+) (*tokenManagementBuilder, error) {
+	if networkConfig == nil {
+		return nil, ErrNilNetworkConfig
+	}
+
+	esdtSystemSCAddress, err := encodeSystemAddress(ESDTSystemSCAddress, core.AddressConverterOrDefault(addressConverter))
+	if err != nil {
+		return nil, err
+	}
+
+	issueCost, _ := big.NewInt(0).SetString(defaultESDTIssueCost, 10)
+
+	return &tokenManagementBuilder{
+		networkConfig:       networkConfig,
+		issueCost:           issueCost,
+		esdtSystemSCAddress: esdtSystemSCAddress,
+	}, nil
+}
+
+// SetIssueCost sets the cost paid when issuing or registering a token. It defaults to 0.05 EGLD,
+// the actual value can be fetched with the query returned by NewESDTContractConfigQueryBuilder
+func (builder *tokenManagementBuilder) SetIssueCost(issueCost *big.Int) error {
+	if issueCost == nil {
+		return ErrNilValue
+	}
+	if issueCost.Sign() < 0 {
+		return ErrInvalidValue
+	}
+
+	builder.issueCost = big.NewInt(0).Set(issueCost)
+
+	return nil
+}
+
+// Issue builds the transaction that issues a new fungible token
+func (builder *tokenManagementBuilder) Issue(sender *data.Account, args IssueFungibleArgs) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().
+		Function("issue").
+		ArgBytes([]byte(args.TokenName)).
+		ArgBytes([]byte(args.TokenTicker)).
+		ArgBigInt(args.InitialSupply).
+		ArgInt64(int64(args.NumDecimals))
+	addProperties(dataBuilder, args.Properties, false)
+
+	return builder.createTransaction(sender, builder.issueCost, dataBuilder)
+}
+
+// IssueSemiFungible builds the transaction that issues a new semi-fungible token
+func (builder *tokenManagementBuilder) IssueSemiFungible(sender *data.Account, args IssueNonFungibleArgs) (*transaction.FrontendTransaction, error) {
+	return builder.issueNonFungible("issueSemiFungible", sender, args)
+}
+
+// IssueNonFungible builds the transaction that issues a new non-fungible token
+func (builder *tokenManagementBuilder) IssueNonFungible(sender *data.Account, args IssueNonFungibleArgs) (*transaction.FrontendTransaction, error) {
+	return builder.issueNonFungible("issueNonFungible", sender, args)
+}
+
+func (builder *tokenManagementBuilder) issueNonFungible(function string, sender *data.Account, args IssueNonFungibleArgs) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().
+		Function(function).
+		ArgBytes([]byte(args.TokenName)).
+		ArgBytes([]byte(args.TokenTicker))
+	addProperties(dataBuilder, args.Properties, true)
+
+	return builder.createTransaction(sender, builder.issueCost, dataBuilder)
+}
+
+// RegisterMetaESDT builds the transaction that registers a new meta ESDT token
+func (builder *tokenManagementBuilder) RegisterMetaESDT(sender *data.Account, args RegisterMetaESDTArgs) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().
+		Function("registerMetaESDT").
+		ArgBytes([]byte(args.TokenName)).
+		ArgBytes([]byte(args.TokenTicker)).
+		ArgInt64(int64(args.NumDecimals))
+	addProperties(dataBuilder, args.Properties, true)
+
+	return builder.createTransaction(sender, builder.issueCost, dataBuilder)
+}
+
+// RegisterAndSetAllRoles builds the transaction that registers a new token and sets all roles for the sender
+func (builder *tokenManagementBuilder) RegisterAndSetAllRoles(sender *data.Account, args RegisterAndSetAllRolesArgs) (*transaction.FrontendTransaction, error) {
+	switch args.TokenType {
+	case FungibleTokenType, SemiFungibleTokenType, NonFungibleTokenType, MetaESDTTokenType:
+	default:
+		return nil, fmt.Errorf("%w %s", ErrInvalidTokenType, args.TokenType)
+	}
+
+	dataBuilder := NewTxDataBuilder().
+		Function("registerAndSetAllRoles").
+		ArgBytes([]byte(args.TokenName)).
+		ArgBytes([]byte(args.TokenTicker)).
+		ArgBytes([]byte(args.TokenType)).
+		ArgInt64(int64(args.NumDecimals))
+
+	return builder.createTransaction(sender, builder.issueCost, dataBuilder)
+}
+
+// SetSpecialRole builds the transaction that sets the provided roles for the address on the token
+func (builder *tokenManagementBuilder) SetSpecialRole(
+	sender *data.Account,
+	tokenIdentifier string,
+	address core.AddressHandler,
+	roles ...string,
+) (*transaction.FrontendTransaction, error) {
+	return builder.changeSpecialRoles("setSpecialRole", sender, tokenIdentifier, address, roles)
+}
+
+// UnSetSpecialRole builds the transaction that removes the provided roles of the address on the token
+func (builder *tokenManagementBuilder) UnSetSpecialRole(
+	sender *data.Account,
+	tokenIdentifier string,
+	address core.AddressHandler,
+	roles ...string,
+) (*transaction.FrontendTransaction, error) {
+	return builder.changeSpecialRoles("unSetSpecialRole", sender, tokenIdentifier, address, roles)
+}
+
+func (builder *tokenManagementBuilder) changeSpecialRoles(
+	function string,
+	sender *data.Account,
+	tokenIdentifier string,
+	address core.AddressHandler,
+	roles []string,
+) (*transaction.FrontendTransaction, error) {
+	if len(roles) == 0 {
+		return nil, ErrNoRolesProvided
+	}
+
+	dataBuilder := NewTxDataBuilder().
+		Function(function).
+		ArgBytes([]byte(tokenIdentifier)).
+		ArgAddress(address)
+	for _, role := range roles {
+		dataBuilder.ArgBytes([]byte(role))
+	}
+
+	return builder.createTransaction(sender, nil, dataBuilder)
+}
+
+// Freeze builds the transaction that freezes the token balance of the provided address
+func (builder *tokenManagementBuilder) Freeze(sender *data.Account, tokenIdentifier string, address core.AddressHandler) (*transaction.FrontendTransaction, error) {
+	return builder.tokenAddressOperation("freeze", sender, tokenIdentifier, address)
+}
+
+// UnFreeze builds the transaction that unfreezes the token balance of the provided address
+func (builder *tokenManagementBuilder) UnFreeze(sender *data.Account, tokenIdentifier string, address core.AddressHandler) (*transaction.FrontendTransaction, error) {
+	return builder.tokenAddressOperation("unFreeze", sender, tokenIdentifier, address)
+}
+
+// Wipe builds the transaction that wipes out the token balance of the provided (frozen) address
+func (builder *tokenManagementBuilder) Wipe(sender *data.Account, tokenIdentifier string, address core.AddressHandler) (*transaction.FrontendTransaction, error) {
+	return builder.tokenAddressOperation("wipe", sender, tokenIdentifier, address)
+}
+
+// TransferOwnership builds the transaction that transfers the token management rights to the new owner
+func (builder *tokenManagementBuilder) TransferOwnership(sender *data.Account, tokenIdentifier string, newOwner core.AddressHandler) (*transaction.FrontendTransaction, error) {
+	return builder.tokenAddressOperation("transferOwnership", sender, tokenIdentifier, newOwner)
+}
+
+func (builder *tokenManagementBuilder) tokenAddressOperation(
+	function string,
+	sender *data.Account,
+	tokenIdentifier string,
+	address core.AddressHandler,
+) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().
+		Function(function).
+		ArgBytes([]byte(tokenIdentifier)).
+		ArgAddress(address)
+
+	return builder.createTransaction(sender, nil, dataBuilder)
+}
+
+// Pause builds the transaction that pauses all the token transfers
+func (builder *tokenManagementBuilder) Pause(sender *data.Account, tokenIdentifier string) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().
+		Function("pause").
+		ArgBytes([]byte(tokenIdentifier))
+
+	return builder.createTransaction(sender, nil, dataBuilder)
+}
+
+// UnPause builds the transaction that resumes the token transfers
+func (builder *tokenManagementBuilder) UnPause(sender *data.Account, tokenIdentifier string) (*transaction.FrontendTransaction, error) {
+	dataBuilder := NewTxDataBuilder().
+		Function("unPause").
+		ArgBytes([]byte(tokenIdentifier))
+
+	return builder.createTransaction(sender, nil, dataBuilder)
+}
+
+// ControlChanges builds the transaction that changes the provided properties of the token
+func (builder *tokenManagementBuilder) ControlChanges(
+	sender *data.Account,
+	tokenIdentifier string,
+	changes ...TokenPropertyChange,
+) (*transaction.FrontendTransaction, error) {
+	if len(changes) == 0 {
+		return nil, ErrNoPropertiesProvided
+	}
+
+	dataBuilder := NewTxDataBuilder().
+		Function("controlChanges").
+		ArgBytes([]byte(tokenIdentifier))
+	for _, change := range changes {
+		addProperty(dataBuilder, change.Name, change.Value)
+	}
+
+	return builder.createTransaction(sender, nil, dataBuilder)
+}
+
+func (builder *tokenManagementBuilder) createTransaction(
+	sender *data.Account,
+	value *big.Int,
+	dataBuilder TxDataBuilder,
+) (*transaction.FrontendTransaction, error) {
+	return createSCCallTransaction(argsSCCallTransaction{
+		networkConfig: builder.networkConfig,
+		sender:        sender,
+		receiver:      builder.esdtSystemSCAddress,
+		value:         value,
+		dataBuilder:   dataBuilder,
+		executionGas:  gasLimitESDTSystemSCOperation,
+	})
+}
+
+func addProperties(dataBuilder TxDataBuilder, properties TokenProperties, isNonFungible bool) {
+	addProperty(dataBuilder, "canFreeze", properties.CanFreeze)
+	addProperty(dataBuilder, "canWipe", properties.CanWipe)
+	addProperty(dataBuilder, "canPause", properties.CanPause)
+	if isNonFungible {
+		addProperty(dataBuilder, "canTransferNFTCreateRole", properties.CanTransferNFTCreateRole)
+	}
+	addProperty(dataBuilder, "canChangeOwner", properties.CanChangeOwner)
+	addProperty(dataBuilder, "canUpgrade", properties.CanUpgrade)
+	addProperty(dataBuilder, "canAddSpecialRoles", properties.CanAddSpecialRoles)
+}
+
+func addProperty(dataBuilder TxDataBuilder, name string, value bool) {
+	dataBuilder.ArgBytes([]byte(name)).ArgBytes([]byte(fmt.Sprintf("%t", value)))
+}
+
+// NewESDTContractConfigQueryBuilder returns the VM query builder that fetches the ESDT system smart contract config.
+// The issue cost can be extracted from the query result with ParseESDTIssueCost
+func NewESDTContractConfigQueryBuilder() VMQueryBuilder {
+	return NewESDTContractConfigQueryBuilderWithAddressConverter(core.AddressPublicKeyConverter)
+}
+
+// NewESDTContractConfigQueryBuilderWithAddressConverter returns the VM query builder that fetches the ESDT system smart
+// contract config, the contract address being encoded with the provided address converter. A nil converter means the
+// default one
+func NewESDTContractConfigQueryBuilderWithAddressConverter(addressConverter core.AddressConverter) VMQueryBuilder {
+	addressBytes, _ := core.AddressPublicKeyConverter.Decode(ESDTSystemSCAddress)
+
+	return NewVMQueryBuilder().
+		Address(data.NewAddressFromBytesWithConverter(addressBytes, addressConverter)).
+		Function(esdtContractConfigFunction)
+}
+
+// ParseESDTIssueCost returns the issue cost from the ESDT system smart contract config query result
+func ParseESDTIssueCost(returnData [][]byte) (*big.Int, error) {
+	if len(returnData) <= esdtContractConfigIssueCostIndex {
+		return nil, fmt.Errorf("%w, expected at least %d values, got %d", ErrInvalidQueryResult,
+			esdtContractConfigIssueCostIndex+1, len(returnData))
+	}
+
+	return big.NewInt(0).SetBytes(returnData[esdtContractConfigIssueCostIndex]), nil
+}
+
+// ParseIssuedTokenIdentifier returns the identifier of the token issued or registered by the provided transaction
+func ParseIssuedTokenIdentifier(tx *data.TransactionOnNetwork) (string, error) {
+	if tx == nil {
+		return "", ErrNilTransactionOnNetwork
+	}
+
+	events := getAllEvents(tx)
+	err := checkSignalErrorEvents(events)
+	if err != nil {
+		return "", err
+	}
+
+	for _, event := range events {
+		if event == nil {
+			continue
+		}
+
+		switch event.Identifier {
+		case "issue", "issueSemiFungible", "issueNonFungible", "registerMetaESDT", "registerAndSetAllRoles":
+			if len(event.Topics) == 0 || len(event.Topics[0]) == 0 {
+				continue
+			}
+
+			return string(event.Topics[0]), nil
+		}
+	}
+
+	return "", ErrEventNotFound
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (builder *tokenManagementBuilder) IsInterfaceNil() bool {
+	return builder == nil
+}
@@ -0,0 +1,287 @@
+package builders
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTokenManagementBuilder(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil network config should error", func(t *testing.T) {
+		t.Parallel()
+
+		builder, err := NewTokenManagementBuilder(nil)
+		assert.True(t, check.IfNil(builder))
+		assert.Equal(t, ErrNilNetworkConfig, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		builder, err := NewTokenManagementBuilder(createTokenTransferNetworkConfig())
+		assert.False(t, check.IfNil(builder))
+		assert.Nil(t, err)
+	})
+}
+
+func TestTokenManagementBuilder_WithAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	converter := createTestAddressConverter(t)
+	builder, err := NewTokenManagementBuilderWithAddressConverter(nil, converter)
+	assert.True(t, check.IfNil(builder))
+	assert.Equal(t, ErrNilNetworkConfig, err)
+
+	builder, err = NewTokenManagementBuilderWithAddressConverter(createTokenTransferNetworkConfig(), converter)
+	require.Nil(t, err)
+
+	sender := &data.Account{Address: convertTestAddress(t, testTokenSender, converter), Nonce: 3}
+	tx, err := builder.Freeze(sender, "TKN-abcdef", data.NewAddressFromBytes(make([]byte, 32)))
+	require.Nil(t, err)
+	assert.Equal(t, convertTestAddress(t, ESDTSystemSCAddress, converter), tx.Receiver)
+	assert.Equal(t, sender.Address, tx.Sender)
+}
+
+func TestTokenManagementBuilder_Issue(t *testing.T) {
+	t.Parallel()
+
+	sender := &data.Account{
+		Address: testTokenSender,
+		Nonce:   3,
+	}
+	builder, _ := NewTokenManagementBuilder(createTokenTransferNetworkConfig())
+
+	t.Run("fungible token", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Issue(sender, IssueFungibleArgs{
+			TokenName:     "Token",
+			TokenTicker:   "TKN",
+			InitialSupply: big.NewInt(1000),
+			NumDecimals:   6,
+			Properties: TokenProperties{
+				CanFreeze:                true,
+				CanUpgrade:               true,
+				CanWipe:                  false,
+				CanPause:                 false,
+				CanTransferNFTCreateRole: true,
+			},
+		})
+		require.Nil(t, err)
+
+		expectedData := "issue@546f6b656e@544b4e@03e8@06" +
+			"@63616e467265657a65@74727565" +
+			"@63616e57697065@66616c7365" +
+			"@63616e5061757365@66616c7365" +
+			"@63616e4368616e67654f776e6572@66616c7365" +
+			"@63616e55706772616465@74727565" +
+			"@63616e4164645370656369616c526f6c6573@66616c7365"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, ESDTSystemSCAddress, tx.Receiver)
+		assert.Equal(t, "50000000000000000", tx.Value)
+		assert.Equal(t, uint64(3), tx.Nonce)
+		assert.Equal(t, uint64(50000+1500*len(expectedData)+60000000), tx.GasLimit)
+	})
+	t.Run("non-fungible token with custom issue cost", func(t *testing.T) {
+		t.Parallel()
+
+		localBuilder, _ := NewTokenManagementBuilder(createTokenTransferNetworkConfig())
+		err := localBuilder.SetIssueCost(big.NewInt(37))
+		require.Nil(t, err)
+
+		tx, err := localBuilder.IssueNonFungible(sender, IssueNonFungibleArgs{
+			TokenName:   "Token",
+			TokenTicker: "TKN",
+			Properties: TokenProperties{
+				CanTransferNFTCreateRole: true,
+			},
+		})
+		require.Nil(t, err)
+
+		expectedData := "issueNonFungible@546f6b656e@544b4e" +
+			"@63616e467265657a65@66616c7365" +
+			"@63616e57697065@66616c7365" +
+			"@63616e5061757365@66616c7365" +
+			"@63616e5472616e736665724e4654437265617465526f6c65@74727565" +
+			"@63616e4368616e67654f776e6572@66616c7365" +
+			"@63616e55706772616465@66616c7365" +
+			"@63616e4164645370656369616c526f6c6573@66616c7365"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, "37", tx.Value)
+	})
+	t.Run("register and set all roles with invalid type should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.RegisterAndSetAllRoles(sender, RegisterAndSetAllRolesArgs{
+			TokenName:   "Token",
+			TokenTicker: "TKN",
+			TokenType:   "invalid",
+		})
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrInvalidTokenType))
+	})
+	t.Run("nil sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Pause(nil, "TKN-abcdef")
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNilSenderAccount, err)
+	})
+}
+
+func TestTokenManagementBuilder_Operations(t *testing.T) {
+	t.Parallel()
+
+	sender := &data.Account{
+		Address: testTokenSender,
+	}
+	builder, _ := NewTokenManagementBuilder(createTokenTransferNetworkConfig())
+	address, _ := data.NewAddressFromBech32String(testTokenReceiver)
+	addressHex := "000000000000000005004888d06daef6d4ce8a01d72812d08617b4b504a369e1"
+
+	t.Run("set special role without roles should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.SetSpecialRole(sender, "TKN-abcdef", address)
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNoRolesProvided, err)
+	})
+	t.Run("set special role", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.SetSpecialRole(sender, "TKN-abcdef", address, "ESDTRoleLocalMint", "ESDTRoleLocalBurn")
+		require.Nil(t, err)
+		assert.Equal(t, "setSpecialRole@544b4e2d616263646566@"+addressHex+"@45534454526f6c654c6f63616c4d696e74@45534454526f6c654c6f63616c4275726e", string(tx.Data))
+		assert.Equal(t, "0", tx.Value)
+	})
+	t.Run("freeze", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Freeze(sender, "TKN-abcdef", address)
+		require.Nil(t, err)
+		assert.Equal(t, "freeze@544b4e2d616263646566@"+addressHex, string(tx.Data))
+	})
+	t.Run("pause", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.Pause(sender, "TKN-abcdef")
+		require.Nil(t, err)
+		assert.Equal(t, "pause@544b4e2d616263646566", string(tx.Data))
+	})
+	t.Run("control changes", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := builder.ControlChanges(sender, "TKN-abcdef", TokenPropertyChange{Name: "canWipe", Value: true})
+		require.Nil(t, err)
+		assert.Equal(t, "controlChanges@544b4e2d616263646566@63616e57697065@74727565", string(tx.Data))
+	})
+}
+
+func TestNewESDTContractConfigQueryBuilder(t *testing.T) {
+	t.Parallel()
+
+	request, err := NewESDTContractConfigQueryBuilder().ToVmValueRequest()
+	require.Nil(t, err)
+	assert.Equal(t, ESDTSystemSCAddress, request.Address)
+	assert.Equal(t, "getContractConfig", request.FuncName)
+}
+
+func TestNewESDTContractConfigQueryBuilderWithAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	converter := createTestAddressConverter(t)
+	request, err := NewESDTContractConfigQueryBuilderWithAddressConverter(converter).ToVmValueRequest()
+	require.Nil(t, err)
+	assert.Equal(t, convertTestAddress(t, ESDTSystemSCAddress, converter), request.Address)
+	assert.Equal(t, "getContractConfig", request.FuncName)
+}
+
+func TestParseESDTIssueCost(t *testing.T) {
+	t.Parallel()
+
+	cost, err := ParseESDTIssueCost([][]byte{[]byte("owner")})
+	assert.Nil(t, cost)
+	assert.True(t, errors.Is(err, ErrInvalidQueryResult))
+
+	cost, err = ParseESDTIssueCost([][]byte{[]byte("owner"), big.NewInt(50000000000000000).Bytes()})
+	assert.Nil(t, err)
+	assert.Equal(t, big.NewInt(50000000000000000), cost)
+}
+
+func TestParseIssuedTokenIdentifier(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		identifier, err := ParseIssuedTokenIdentifier(nil)
+		assert.Empty(t, identifier)
+		assert.Equal(t, ErrNilTransactionOnNetwork, err)
+	})
+	t.Run("signal error event should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &data.TransactionOnNetwork{
+			Logs: &transaction.ApiLogs{
+				Events: []*transaction.Events{
+					{
+						Identifier: "signalError",
+						Topics:     [][]byte{[]byte("addr"), []byte("ticker name is not valid")},
+					},
+				},
+			},
+		}
+		identifier, err := ParseIssuedTokenIdentifier(tx)
+		assert.Empty(t, identifier)
+		assert.True(t, errors.Is(err, ErrTransactionFailed))
+		assert.Contains(t, err.Error(), "ticker name is not valid")
+	})
+	t.Run("missing event should error", func(t *testing.T) {
+		t.Parallel()
+
+		identifier, err := ParseIssuedTokenIdentifier(&data.TransactionOnNetwork{})
+		assert.Empty(t, identifier)
+		assert.Equal(t, ErrEventNotFound, err)
+	})
+	t.Run("event in smart contract results should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &data.TransactionOnNetwork{
+			ScResults: []*transaction.ApiSmartContractResult{
+				{
+					Logs: &transaction.ApiLogs{
+						Events: []*transaction.Events{
+							nil,
+							{
+								Identifier: "issueNonFungible",
+								Topics:     [][]byte{[]byte("TKN-abcdef"), []byte("Token"), []byte("TKN")},
+							},
+						},
+					},
+				},
+			},
+		}
+		identifier, err := ParseIssuedTokenIdentifier(tx)
+		assert.Nil(t, err)
+		assert.Equal(t, "TKN-abcdef", identifier)
+	})
+	t.Run("nil events should be skipped", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &data.TransactionOnNetwork{
+			Logs: &transaction.ApiLogs{
+				Events: []*transaction.Events{nil},
+			},
+		}
+		identifier, err := ParseIssuedTokenIdentifier(tx)
+		assert.Empty(t, identifier)
+		assert.Equal(t, ErrEventNotFound, err)
+	})
+}
@@ -0,0 +1,216 @@
+package builders
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	gasLimitESDTTransfer            = 200000
+	additionalGasForESDTTransfer    = 100000
+	gasLimitESDTNFTTransfer         = 200000
+	additionalGasForESDTNFTTransfer = 800000
+	gasLimitPerMultiESDTNFTTransfer = 200000
+)
+
+// TokenTransfer holds the token identifier, nonce and amount of a token transfer.
+// A 0 nonce denotes a fungible ESDT
+type TokenTransfer struct {
+	TokenIdentifier string
+	Nonce           uint64
+	Amount          *big.Int
+}
+
+type tokenTransferBuilder struct {
+	senderAccount     *data.Account
+	receiver          string
+	transfers         []*TokenTransfer
+	function          string
+	args              [][]byte
+	gasLimitForSCCall uint64
+	networkConfig     *data.NetworkConfig
+	addressConverter  sdkCore.AddressConverter
+}
+
+// NewTokenTransferBuilder creates a new token transfer builder able to generate ESDTTransfer, ESDTNFTTransfer
+// and MultiESDTNFTTransfer transactions
+func NewTokenTransferBuilder() *tokenTransferBuilder {
+	return &tokenTransferBuilder{
+		senderAccount:    nil,
+		networkConfig:    nil,
+		addressConverter: sdkCore.AddressPublicKeyConverter,
+	}
+}
+
+// SetSenderAccount sets the account that will send the tokens
+func (ttb *tokenTransferBuilder) SetSenderAccount(account *data.Account) *tokenTransferBuilder {
+	ttb.senderAccount = account
+
+	return ttb
+}
+
+// SetReceiver sets the bech32 address of the tokens' destination
+func (ttb *tokenTransferBuilder) SetReceiver(receiver string) *tokenTransferBuilder {
+	ttb.receiver = receiver
+
+	return ttb
+}
+
+// AddTokenTransfer adds a new token to be transferred. The nonce should be 0 for fungible tokens
+func (ttb *tokenTransferBuilder) AddTokenTransfer(tokenIdentifier string, nonce uint64, amount *big.Int) *tokenTransferBuilder {
+	ttb.transfers = append(ttb.transfers, &TokenTransfer{
+		TokenIdentifier: tokenIdentifier,
+		Nonce:           nonce,
+		Amount:          amount,
+	})
+
+	return ttb
+}
+
+// AddFungibleTokenAmount adds a new fungible token to be transferred, the amount's ticker being the token identifier
+func (ttb *tokenTransferBuilder) AddFungibleTokenAmount(amount *data.Amount) *tokenTransferBuilder {
+	if amount == nil {
+		return ttb.AddTokenTransfer("", 0, nil)
+	}
+
+	return ttb.AddTokenTransfer(amount.Ticker(), 0, amount.Value())
+}
+
+// SetContractCall sets the smart contract function (and its arguments) that will be called on the receiver
+// after the tokens are transferred
+func (ttb *tokenTransferBuilder) SetContractCall(function string, args ...[]byte) *tokenTransferBuilder {
+	ttb.function = function
+	ttb.args = args
+
+	return ttb
+}
+
+// SetGasLimitForContractCall sets the gas limit needed by the smart contract function call
+func (ttb *tokenTransferBuilder) SetGasLimitForContractCall(gasLimit uint64) *tokenTransferBuilder {
+	ttb.gasLimitForSCCall = gasLimit
+
+	return ttb
+}
+
+// SetNetworkConfig sets the network config
+func (ttb *tokenTransferBuilder) SetNetworkConfig(config *data.NetworkConfig) *tokenTransferBuilder {
+	ttb.networkConfig = config
+
+	return ttb
+}
+
+// SetAddressConverter sets the address converter used to decode the bech32 addresses, for the chains that do not use
+// the default address prefix. A nil converter means the default one
+func (ttb *tokenTransferBuilder) SetAddressConverter(addressConverter sdkCore.AddressConverter) *tokenTransferBuilder {
+	ttb.addressConverter = sdkCore.AddressConverterOrDefault(addressConverter)
+
+	return ttb
+}
+
+// Build builds the token transfer transaction
+// The returned transaction will not be signed
+func (ttb *tokenTransferBuilder) Build() (*transaction.FrontendTransaction, error) {
+	err := ttb.checkFields()
+	if err != nil {
+		return nil, err
+	}
+
+	receiverAddress, err := data.NewAddressFromBech32StringWithConverter(ttb.receiver, ttb.addressConverter)
+	if err != nil {
+		return nil, fmt.Errorf("%w for receiver %s", err, ttb.receiver)
+	}
+
+	dataBuilder := NewTxDataBuilder()
+	txReceiver := ttb.senderAccount.Address
+	var transferGasLimit uint64
+	switch {
+	case len(ttb.transfers) > 1:
+		dataBuilder.Function(core.BuiltInFunctionMultiESDTNFTTransfer).
+			ArgAddress(receiverAddress).
+			ArgInt64(int64(len(ttb.transfers)))
+		for _, transfer := range ttb.transfers {
+			dataBuilder.ArgBytes([]byte(transfer.TokenIdentifier)).
+				ArgBigInt(big.NewInt(0).SetUint64(transfer.Nonce)).
+				ArgBigInt(transfer.Amount)
+		}
+		transferGasLimit = gasLimitPerMultiESDTNFTTransfer*uint64(len(ttb.transfers)) + additionalGasForESDTNFTTransfer
+	case ttb.transfers[0].Nonce > 0:
+		transfer := ttb.transfers[0]
+		dataBuilder.Function(core.BuiltInFunctionESDTNFTTransfer).
+			ArgBytes([]byte(transfer.TokenIdentifier)).
+			ArgBigInt(big.NewInt(0).SetUint64(transfer.Nonce)).
+			ArgBigInt(transfer.Amount).
+			ArgAddress(receiverAddress)
+		transferGasLimit = gasLimitESDTNFTTransfer + additionalGasForESDTNFTTransfer
+	default:
+		transfer := ttb.transfers[0]
+		dataBuilder.Function(core.BuiltInFunctionESDTTransfer).
+			ArgBytes([]byte(transfer.TokenIdentifier)).
+			ArgBigInt(transfer.Amount)
+		transferGasLimit = gasLimitESDTTransfer + additionalGasForESDTTransfer
+		txReceiver = ttb.receiver
+	}
+
+	if len(ttb.function) > 0 {
+		dataBuilder.ArgBytes([]byte(ttb.function))
+		for _, arg := range ttb.args {
+			dataBuilder.ArgHexString(hex.EncodeToString(arg))
+		}
+	}
+
+	payload, err := dataBuilder.ToDataBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	tx := &transaction.FrontendTransaction{
+		Nonce:    ttb.senderAccount.Nonce,
+		Value:    "0",
+		Receiver: txReceiver,
+		Sender:   ttb.senderAccount.Address,
+		GasPrice: ttb.networkConfig.MinGasPrice,
+		Data:     payload,
+		ChainID:  ttb.networkConfig.ChainID,
+		Version:  ttb.networkConfig.MinTransactionVersion,
+	}
+	tx.GasLimit = ttb.networkConfig.ComputeMoveBalanceGasLimit(tx) + transferGasLimit + ttb.gasLimitForSCCall
+
+	return tx, nil
+}
+
+func (ttb *tokenTransferBuilder) checkFields() error {
+	if ttb.senderAccount == nil {
+		return ErrNilSenderAccount
+	}
+	if ttb.networkConfig == nil {
+		return ErrNilNetworkConfig
+	}
+	if len(ttb.transfers) == 0 {
+		return ErrNoTokenTransfers
+	}
+	for _, transfer := range ttb.transfers {
+		if len(transfer.TokenIdentifier) == 0 {
+			return ErrInvalidTokenIdentifier
+		}
+		if transfer.Amount == nil {
+			return fmt.Errorf("%w for token %s", ErrNilValue, transfer.TokenIdentifier)
+		}
+		if transfer.Amount.Sign() <= 0 {
+			return fmt.Errorf("%w for token %s", ErrInvalidValue, transfer.TokenIdentifier)
+		}
+	}
+	if len(ttb.function) == 0 && len(ttb.args) > 0 {
+		return ErrMissingContractFunction
+	}
+	if len(ttb.function) > 0 && ttb.gasLimitForSCCall == 0 {
+		return ErrInvalidGasLimitNeededForContractCall
+	}
+
+	return nil
+}
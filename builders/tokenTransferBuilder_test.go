@@ -0,0 +1,248 @@
+package builders
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testTokenSender   = "erd1h692scsz3um6e5qwzts4yjrewxqxwcwxzavl5n9q8sprussx8fqsu70jf5"
+	testTokenReceiver = "erd1qqqqqqqqqqqqqpgqfzydqmdw7m2vazsp6u5p95yxz76t2p9rd8ss0zp9ts"
+)
+
+func createTokenTransferNetworkConfig() *data.NetworkConfig {
+	return &data.NetworkConfig{
+		ChainID:               "T",
+		MinTransactionVersion: 1,
+		GasPerDataByte:        1500,
+		MinGasLimit:           50000,
+		MinGasPrice:           1000000000,
+	}
+}
+
+func createTestAddressConverter(t *testing.T) core.AddressConverter {
+	converter, err := core.NewAddressPublicKeyConverter("sov")
+	require.Nil(t, err)
+
+	return converter
+}
+
+// convertTestAddress re-encodes a bech32 test address using the provided address converter
+func convertTestAddress(t *testing.T, bech32Address string, converter core.AddressConverter) string {
+	addressBytes, err := core.AddressPublicKeyConverter.Decode(bech32Address)
+	require.Nil(t, err)
+
+	converted, err := converter.Encode(addressBytes)
+	require.Nil(t, err)
+
+	return converted
+}
+
+func TestTokenTransferBuilder_Build(t *testing.T) {
+	t.Parallel()
+
+	senderAccount := &data.Account{
+		Address: testTokenSender,
+		Nonce:   7,
+	}
+
+	t.Run("nil sender account should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := NewTokenTransferBuilder().
+			SetReceiver(testTokenReceiver).
+			SetNetworkConfig(createTokenTransferNetworkConfig()).
+			AddTokenTransfer("USDC-c76f1f", 0, big.NewInt(1)).
+			Build()
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNilSenderAccount, err)
+	})
+	t.Run("nil network config should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := NewTokenTransferBuilder().
+			SetSenderAccount(senderAccount).
+			SetReceiver(testTokenReceiver).
+			AddTokenTransfer("USDC-c76f1f", 0, big.NewInt(1)).
+			Build()
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNilNetworkConfig, err)
+	})
+	t.Run("no transfers should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := NewTokenTransferBuilder().
+			SetSenderAccount(senderAccount).
+			SetReceiver(testTokenReceiver).
+			SetNetworkConfig(createTokenTransferNetworkConfig()).
+			Build()
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrNoTokenTransfers, err)
+	})
+	t.Run("invalid amount should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := NewTokenTransferBuilder().
+			SetSenderAccount(senderAccount).
+			SetReceiver(testTokenReceiver).
+			SetNetworkConfig(createTokenTransferNetworkConfig()).
+			AddTokenTransfer("USDC-c76f1f", 0, big.NewInt(0)).
+			Build()
+		assert.Nil(t, tx)
+		assert.True(t, errors.Is(err, ErrInvalidValue))
+	})
+	t.Run("contract call without gas limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := NewTokenTransferBuilder().
+			SetSenderAccount(senderAccount).
+			SetReceiver(testTokenReceiver).
+			SetNetworkConfig(createTokenTransferNetworkConfig()).
+			AddTokenTransfer("USDC-c76f1f", 0, big.NewInt(1)).
+			SetContractCall("deposit").
+			Build()
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrInvalidGasLimitNeededForContractCall, err)
+	})
+	t.Run("fungible transfer should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := NewTokenTransferBuilder().
+			SetSenderAccount(senderAccount).
+			SetReceiver(testTokenReceiver).
+			SetNetworkConfig(createTokenTransferNetworkConfig()).
+			AddTokenTransfer("USDC-c76f1f", 0, big.NewInt(1000000)).
+			Build()
+		require.Nil(t, err)
+
+		expectedData := "ESDTTransfer@555344432d633736663166@0f4240"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, testTokenReceiver, tx.Receiver)
+		assert.Equal(t, testTokenSender, tx.Sender)
+		assert.Equal(t, "0", tx.Value)
+		assert.Equal(t, uint64(7), tx.Nonce)
+		assert.Equal(t, uint64(50000+1500*len(expectedData)+300000), tx.GasLimit)
+	})
+	t.Run("fungible transfer from amount should work", func(t *testing.T) {
+		t.Parallel()
+
+		amount, _ := data.ParseAmount("1 USDC-c76f1f", 6)
+		tx, err := NewTokenTransferBuilder().
+			SetSenderAccount(senderAccount).
+			SetReceiver(testTokenReceiver).
+			SetNetworkConfig(createTokenTransferNetworkConfig()).
+			AddFungibleTokenAmount(amount).
+			Build()
+		require.Nil(t, err)
+		assert.Equal(t, "ESDTTransfer@555344432d633736663166@0f4240", string(tx.Data))
+
+		tx, err = NewTokenTransferBuilder().
+			SetSenderAccount(senderAccount).
+			SetReceiver(testTokenReceiver).
+			SetNetworkConfig(createTokenTransferNetworkConfig()).
+			AddFungibleTokenAmount(nil).
+			Build()
+		assert.Nil(t, tx)
+		assert.Equal(t, ErrInvalidTokenIdentifier, err)
+	})
+	t.Run("fungible transfer with contract call should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := NewTokenTransferBuilder().
+			SetSenderAccount(senderAccount).
+			SetReceiver(testTokenReceiver).
+			SetNetworkConfig(createTokenTransferNetworkConfig()).
+			AddTokenTransfer("USDC-c76f1f", 0, big.NewInt(1000000)).
+			SetContractCall("deposit", []byte{1}, []byte{}).
+			SetGasLimitForContractCall(5000000).
+			Build()
+		require.Nil(t, err)
+
+		expectedData := "ESDTTransfer@555344432d633736663166@0f4240@6465706f736974@01@"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, testTokenReceiver, tx.Receiver)
+		assert.Equal(t, uint64(50000+1500*len(expectedData)+300000+5000000), tx.GasLimit)
+	})
+	t.Run("NFT transfer should set the sender as receiver", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := NewTokenTransferBuilder().
+			SetSenderAccount(senderAccount).
+			SetReceiver(testTokenReceiver).
+			SetNetworkConfig(createTokenTransferNetworkConfig()).
+			AddTokenTransfer("NFT-123456", 10, big.NewInt(1)).
+			Build()
+		require.Nil(t, err)
+
+		expectedData := "ESDTNFTTransfer@4e46542d313233343536@0a@01@000000000000000005004888d06daef6d4ce8a01d72812d08617b4b504a369e1"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, testTokenSender, tx.Receiver)
+		assert.Equal(t, uint64(50000+1500*len(expectedData)+1000000), tx.GasLimit)
+	})
+	t.Run("multiple transfers should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := NewTokenTransferBuilder().
+			SetSenderAccount(senderAccount).
+			SetReceiver(testTokenReceiver).
+			SetNetworkConfig(createTokenTransferNetworkConfig()).
+			AddTokenTransfer("USDC-c76f1f", 0, big.NewInt(1000000)).
+			AddTokenTransfer("NFT-123456", 10, big.NewInt(1)).
+			SetContractCall("deposit").
+			SetGasLimitForContractCall(5000000).
+			Build()
+		require.Nil(t, err)
+
+		expectedData := "MultiESDTNFTTransfer@000000000000000005004888d06daef6d4ce8a01d72812d08617b4b504a369e1@02" +
+			"@555344432d633736663166@00@0f4240" +
+			"@4e46542d313233343536@0a@01" +
+			"@6465706f736974"
+		assert.Equal(t, expectedData, string(tx.Data))
+		assert.Equal(t, testTokenSender, tx.Receiver)
+		assert.Equal(t, uint64(50000+1500*len(expectedData)+2*200000+800000+5000000), tx.GasLimit)
+	})
+}
+
+func TestTokenTransferBuilder_SetAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	converter := createTestAddressConverter(t)
+	senderAccount := &data.Account{
+		Address: convertTestAddress(t, testTokenSender, converter),
+		Nonce:   7,
+	}
+	receiver := convertTestAddress(t, testTokenReceiver, converter)
+
+	t.Run("default converter should not decode a custom prefixed receiver", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := NewTokenTransferBuilder().
+			SetSenderAccount(senderAccount).
+			SetReceiver(receiver).
+			SetNetworkConfig(createTokenTransferNetworkConfig()).
+			AddTokenTransfer("USDC-c76f1f", 0, big.NewInt(1000000)).
+			Build()
+		assert.Nil(t, tx)
+		assert.NotNil(t, err)
+	})
+	t.Run("custom converter should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := NewTokenTransferBuilder().
+			SetSenderAccount(senderAccount).
+			SetReceiver(receiver).
+			SetNetworkConfig(createTokenTransferNetworkConfig()).
+			SetAddressConverter(converter).
+			AddTokenTransfer("USDC-c76f1f", 0, big.NewInt(1000000)).
+			Build()
+		require.Nil(t, err)
+		assert.Equal(t, receiver, tx.Receiver)
+		assert.Equal(t, "ESDTTransfer@555344432d633736663166@0f4240", string(tx.Data))
+	})
+}
@@ -0,0 +1,47 @@
+package builders
+
+import (
+	"fmt"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	signalErrorEventIdentifier = "signalError"
+	signalErrorMessageIndex    = 1
+)
+
+// getAllEvents returns the events generated by the transaction and by all its smart contract results
+func getAllEvents(tx *data.TransactionOnNetwork) []*transaction.Events {
+	events := make([]*transaction.Events, 0)
+	if tx.Logs != nil {
+		events = append(events, tx.Logs.Events...)
+	}
+	for _, scr := range tx.ScResults {
+		if scr == nil || scr.Logs == nil {
+			continue
+		}
+
+		events = append(events, scr.Logs.Events...)
+	}
+
+	return events
+}
+
+func checkSignalErrorEvents(events []*transaction.Events) error {
+	for _, event := range events {
+		if event == nil || event.Identifier != signalErrorEventIdentifier {
+			continue
+		}
+
+		message := ""
+		if len(event.Topics) > signalErrorMessageIndex {
+			message = string(event.Topics[signalErrorMessageIndex])
+		}
+
+		return fmt.Errorf("%w: %s", ErrTransactionFailed, message)
+	}
+
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"encoding/hex"
 	"encoding/json"
+	"fmt"
 	"math/big"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
@@ -23,17 +24,26 @@ var (
 )
 
 type txBuilder struct {
-	signer Signer
+	signer           Signer
+	addressConverter core.AddressConverter
 }
 
 // NewTxBuilder will create a new transaction builder able to build and correctly sign a transaction
 func NewTxBuilder(signer Signer) (*txBuilder, error) {
+	return NewTxBuilderWithAddressConverter(signer, core.AddressPublicKeyConverter)
+}
+
+// NewTxBuilderWithAddressConverter will create a new transaction builder that decodes the transactions' addresses
+// using the provided address converter, for the chains that do not use the default address prefix. A nil converter
+// means the default one
+func NewTxBuilderWithAddressConverter(signer Signer, addressConverter core.AddressConverter) (*txBuilder, error) {
 	if check.IfNil(signer) {
 		return nil, ErrNilSigner
 	}
 
 	return &txBuilder{
-		signer: signer,
+		signer:           signer,
+		addressConverter: core.AddressConverterOrDefault(addressConverter),
 	}, nil
 }
 
@@ -42,10 +52,23 @@ func (builder *txBuilder) ApplyUserSignature(
 	cryptoHolder core.CryptoComponentsHolder,
 	tx *transaction.FrontendTransaction,
 ) error {
-	tx.Sender = cryptoHolder.GetBech32()
+	return builder.ApplyUserSignatureWithSigner(builder.createTransactionSigner(cryptoHolder), tx)
+}
+
+// ApplyUserSignatureWithSigner will apply the corresponding sender and compute and set the user signature field
+// using the provided transaction signer
+func (builder *txBuilder) ApplyUserSignatureWithSigner(
+	txSigner core.TransactionSigner,
+	tx *transaction.FrontendTransaction,
+) error {
+	if check.IfNil(txSigner) {
+		return ErrNilTransactionSigner
+	}
+
+	tx.Sender = txSigner.GetBech32()
 	unsignedTx := TransactionToUnsignedTx(tx)
 
-	signature, err := builder.signTx(unsignedTx, cryptoHolder)
+	signature, err := builder.signTx(unsignedTx, txSigner)
 	if err != nil {
 		return err
 	}
@@ -55,20 +78,49 @@ func (builder *txBuilder) ApplyUserSignature(
 	return nil
 }
 
-func (builder *txBuilder) signTx(unsignedTx *transaction.FrontendTransaction, userCryptoHolder core.CryptoComponentsHolder) ([]byte, error) {
+func (builder *txBuilder) createTransactionSigner(cryptoHolder core.CryptoComponentsHolder) core.TransactionSigner {
+	if check.IfNil(cryptoHolder) {
+		return nil
+	}
+
+	return &cryptoHolderTransactionSigner{
+		signer:       builder.signer,
+		cryptoHolder: cryptoHolder,
+	}
+}
+
+func (builder *txBuilder) signTx(unsignedTx *transaction.FrontendTransaction, txSigner core.TransactionSigner) ([]byte, error) {
+	unsignedMessage, err := builder.ComputeDataForSigning(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return txSigner.SignTransaction(unsignedTx, unsignedMessage)
+}
+
+// ComputeDataForSigning returns the bytes that are signed by the sender, the guardian and the relayer: the serialized
+// unsigned transaction or, if the sign-with-hash option is set, its keccak hash. The latter is useful for the external
+// signers (hardware wallets, for example) that can not handle large payloads
+func (builder *txBuilder) ComputeDataForSigning(tx *transaction.FrontendTransaction) ([]byte, error) {
 	// TODO: refactor to use Transaction from core so that GetDataForSigning can be used (this logic is duplicated in core)
+	unsignedTx := TransactionToUnsignedTx(tx)
 	unsignedMessage, err := json.Marshal(unsignedTx)
 	if err != nil {
 		return nil, err
 	}
 
-	shouldSignOnTxHash := unsignedTx.Version >= 2 && unsignedTx.Options&1 > 0
-	if shouldSignOnTxHash {
-		log.Debug("signing the transaction using the hash of the message")
-		unsignedMessage = hashSigningTxHasher.Compute(string(unsignedMessage))
+	isSignedWithHash := unsignedTx.Options&transaction.MaskSignedWithHash > 0
+	if !isSignedWithHash {
+		return unsignedMessage, nil
 	}
+	if unsignedTx.Version < minTransactionVersionWithOptions {
+		return nil, fmt.Errorf("%w: the sign-with-hash option requires at least version %d, got %d",
+			ErrInvalidTransactionOptions, minTransactionVersionWithOptions, unsignedTx.Version)
+	}
+
+	log.Debug("signing the transaction using the hash of the message")
 
-	return builder.signer.SignByteSlice(unsignedMessage, userCryptoHolder.GetPrivateKey())
+	return hashSigningTxHasher.Compute(string(unsignedMessage)), nil
 }
 
 // ApplyGuardianSignature applies the guardian signature over the transaction.
@@ -77,7 +129,20 @@ func (builder *txBuilder) ApplyGuardianSignature(
 	guardianCryptoHolder core.CryptoComponentsHolder,
 	tx *transaction.FrontendTransaction,
 ) error {
-	nodeTx, err := transactionToNodeTransaction(tx)
+	return builder.ApplyGuardianSignatureWithSigner(builder.createTransactionSigner(guardianCryptoHolder), tx)
+}
+
+// ApplyGuardianSignatureWithSigner applies the guardian signature over the transaction using the provided
+// transaction signer. Does a basic check for the transaction options and guardian address.
+func (builder *txBuilder) ApplyGuardianSignatureWithSigner(
+	guardianTxSigner core.TransactionSigner,
+	tx *transaction.FrontendTransaction,
+) error {
+	if check.IfNil(guardianTxSigner) {
+		return ErrNilTransactionSigner
+	}
+
+	nodeTx, err := transactionToNodeTransaction(tx, builder.addressConverter)
 	if err != nil {
 		return err
 	}
@@ -86,22 +151,17 @@ func (builder *txBuilder) ApplyGuardianSignature(
 		return ErrMissingGuardianOption
 	}
 
-	txGuardianAddrBytes, err := core.AddressPublicKeyConverter.Decode(tx.GuardianAddr)
+	txGuardianAddrBytes, err := builder.addressConverter.Decode(tx.GuardianAddr)
 	if err != nil {
 		return err
 	}
 
-	guardianPubKeyBytes, err := guardianCryptoHolder.GetPublicKey().ToByteArray()
-	if err != nil {
-		return err
-	}
-
-	if !bytes.Equal(txGuardianAddrBytes, guardianPubKeyBytes) {
+	if !bytes.Equal(txGuardianAddrBytes, guardianTxSigner.GetAddressHandler().AddressBytes()) {
 		return ErrGuardianDoesNotMatch
 	}
 
 	unsignedTx := TransactionToUnsignedTx(tx)
-	guardianSignature, err := builder.signTx(unsignedTx, guardianCryptoHolder)
+	guardianSignature, err := builder.signTx(unsignedTx, guardianTxSigner)
 	if err != nil {
 		return err
 	}
@@ -117,22 +177,30 @@ func (builder *txBuilder) ApplyRelayerSignature(
 	relayerCryptoHolder core.CryptoComponentsHolder,
 	tx *transaction.FrontendTransaction,
 ) error {
-	txRelayerAddrBytes, err := core.AddressPublicKeyConverter.Decode(tx.RelayerAddr)
-	if err != nil {
-		return err
+	return builder.ApplyRelayerSignatureWithSigner(builder.createTransactionSigner(relayerCryptoHolder), tx)
+}
+
+// ApplyRelayerSignatureWithSigner applies the relayer signature over the transaction using the provided transaction
+// signer. Does a basic check for the relayer address.
+func (builder *txBuilder) ApplyRelayerSignatureWithSigner(
+	relayerTxSigner core.TransactionSigner,
+	tx *transaction.FrontendTransaction,
+) error {
+	if check.IfNil(relayerTxSigner) {
+		return ErrNilTransactionSigner
 	}
 
-	relayerPubKeyBytes, err := relayerCryptoHolder.GetPublicKey().ToByteArray()
+	txRelayerAddrBytes, err := builder.addressConverter.Decode(tx.RelayerAddr)
 	if err != nil {
 		return err
 	}
 
-	if !bytes.Equal(txRelayerAddrBytes, relayerPubKeyBytes) {
+	if !bytes.Equal(txRelayerAddrBytes, relayerTxSigner.GetAddressHandler().AddressBytes()) {
 		return ErrRelayerDoesNotMatch
 	}
 
 	unsignedTx := TransactionToUnsignedTx(tx)
-	relayerSignature, err := builder.signTx(unsignedTx, relayerCryptoHolder)
+	relayerSignature, err := builder.signTx(unsignedTx, relayerTxSigner)
 	if err != nil {
 		return err
 	}
@@ -149,7 +217,7 @@ func (builder *txBuilder) ComputeTxHash(tx *transaction.FrontendTransaction) ([]
 		return nil, ErrMissingSignature
 	}
 
-	nodeTx, err := transactionToNodeTransaction(tx)
+	nodeTx, err := transactionToNodeTransaction(tx, builder.addressConverter)
 	if err != nil {
 		return nil, err
 	}
@@ -163,13 +231,16 @@ func (builder *txBuilder) ComputeTxHash(tx *transaction.FrontendTransaction) ([]
 	return txHash, nil
 }
 
-func transactionToNodeTransaction(tx *transaction.FrontendTransaction) (*transaction.Transaction, error) {
-	receiverBytes, err := core.AddressPublicKeyConverter.Decode(tx.Receiver)
+func transactionToNodeTransaction(
+	tx *transaction.FrontendTransaction,
+	addressConverter core.AddressConverter,
+) (*transaction.Transaction, error) {
+	receiverBytes, err := addressConverter.Decode(tx.Receiver)
 	if err != nil {
 		return nil, err
 	}
 
-	senderBytes, err := core.AddressPublicKeyConverter.Decode(tx.Sender)
+	senderBytes, err := addressConverter.Decode(tx.Sender)
 	if err != nil {
 		return nil, err
 	}
@@ -186,7 +257,7 @@ func transactionToNodeTransaction(tx *transaction.FrontendTransaction) (*transac
 
 	var guardianAddrBytes, guardianSigBytes []byte
 	if len(tx.GuardianAddr) > 0 {
-		guardianAddrBytes, err = core.AddressPublicKeyConverter.Decode(tx.GuardianAddr)
+		guardianAddrBytes, err = addressConverter.Decode(tx.GuardianAddr)
 		if err != nil {
 			return nil, err
 		}
@@ -199,7 +270,7 @@ func transactionToNodeTransaction(tx *transaction.FrontendTransaction) (*transac
 
 	var relayerAddrBytes, relayerSigBytes []byte
 	if len(tx.RelayerAddr) > 0 {
-		relayerAddrBytes, err = core.AddressPublicKeyConverter.Decode(tx.RelayerAddr)
+		relayerAddrBytes, err = addressConverter.Decode(tx.RelayerAddr)
 		if err != nil {
 			return nil, err
 		}
@@ -2,16 +2,19 @@ package builders
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"errors"
 	"math/big"
 	"testing"
 
 	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing/keccak"
 	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
 	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/testsCommon"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -39,6 +42,14 @@ func TestNewTxBuilder(t *testing.T) {
 		assert.False(t, check.IfNil(tb))
 		assert.Nil(t, err)
 	})
+	t.Run("nil address converter should use the default one", func(t *testing.T) {
+		t.Parallel()
+
+		tb, err := NewTxBuilderWithAddressConverter(&testsCommon.SignerStub{}, nil)
+		assert.False(t, check.IfNil(tb))
+		assert.Nil(t, err)
+		assert.Equal(t, core.AddressPublicKeyConverter, tb.addressConverter)
+	})
 }
 
 func TestTxBuilder_ApplySignature(t *testing.T) {
@@ -147,6 +158,56 @@ func TestTxBuilder_ApplySignatureAndGenerateTxHash(t *testing.T) {
 	})
 }
 
+func TestTxBuilder_WithAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	converter, err := core.NewAddressPublicKeyConverter("sov")
+	require.Nil(t, err)
+	sk, err := hex.DecodeString("28654d9264f55f18d810bb88617e22c117df94fa684dfe341a511a72dfbf2b68")
+	require.Nil(t, err)
+	cryptoHolder, err := cryptoProvider.NewCryptoComponentsHolderWithAddressConverter(keyGen, sk, converter)
+	require.Nil(t, err)
+	receiver, err := converter.Encode(make([]byte, core.AddressBytesLen))
+	require.Nil(t, err)
+
+	tx := transaction.FrontendTransaction{
+		Nonce:    1,
+		Value:    "1000",
+		Receiver: receiver,
+		GasPrice: 1000000000,
+		GasLimit: 60000,
+		ChainID:  "S",
+		Version:  uint32(1),
+	}
+
+	t.Run("default address converter should error", func(t *testing.T) {
+		t.Parallel()
+
+		txCopy := tx
+		tb, _ := NewTxBuilder(cryptoProvider.NewSigner())
+		errSign := tb.ApplyUserSignature(cryptoHolder, &txCopy)
+		require.Nil(t, errSign)
+
+		txHash, errHash := tb.ComputeTxHash(&txCopy)
+		assert.Nil(t, txHash)
+		assert.NotNil(t, errHash)
+	})
+	t.Run("custom address converter should work", func(t *testing.T) {
+		t.Parallel()
+
+		txCopy := tx
+		tb, _ := NewTxBuilderWithAddressConverter(cryptoProvider.NewSigner(), converter)
+		errSign := tb.ApplyUserSignature(cryptoHolder, &txCopy)
+		require.Nil(t, errSign)
+		assert.Equal(t, cryptoHolder.GetBech32(), txCopy.Sender)
+		assert.Equal(t, "sov1", txCopy.Sender[:4])
+
+		txHash, errHash := tb.ComputeTxHash(&txCopy)
+		assert.Nil(t, errHash)
+		assert.Len(t, txHash, 32)
+	})
+}
+
 func TestTxBuilder_ApplyUserSignatureAndGenerateWithTxGuardian(t *testing.T) {
 	t.Parallel()
 
@@ -286,3 +347,159 @@ func TestTxBuilder_ApplyRelayerSignature(t *testing.T) {
 		require.Nil(t, err)
 	})
 }
+
+func TestTxBuilder_ApplySignaturesWithSigner(t *testing.T) {
+	t.Parallel()
+
+	sk, _ := hex.DecodeString("6ae10fed53a84029e53e35afdbe083688eea0917a09a9431951dd42fd4da14c40d248169f4dd7c90537f05be1c49772ddbf8f7948b507ed17fb23284cf218b7d")
+	cryptoHolder, _ := cryptoProvider.NewCryptoComponentsHolder(keyGen, sk)
+	signer := cryptoProvider.NewSigner()
+	tb, _ := NewTxBuilder(signer)
+
+	var signedPayloads [][]byte
+	txSigner, err := cryptoProvider.NewCallbackTransactionSigner(
+		cryptoHolder.GetAddressHandler().AddressBytes(),
+		func(payload []byte) ([]byte, error) {
+			signedPayloads = append(signedPayloads, payload)
+			return signer.SignByteSlice(payload, cryptoHolder.GetPrivateKey())
+		},
+	)
+	require.Nil(t, err)
+
+	tx := transaction.FrontendTransaction{
+		Nonce:        1,
+		Value:        "1000000000000000000",
+		Receiver:     "erd1p72ru5zcdsvgkkcm9swtvw2zy5epylwgv8vwquptkw7ga7pfvk7qz7snzw",
+		GasPrice:     1000000000,
+		GasLimit:     100000,
+		ChainID:      "T",
+		Version:      uint32(2),
+		Options:      transaction.MaskGuardedTransaction,
+		GuardianAddr: cryptoHolder.GetBech32(),
+		RelayerAddr:  cryptoHolder.GetBech32(),
+	}
+
+	t.Run("nil transaction signer should error", func(t *testing.T) {
+		txLocal := tx
+		assert.Equal(t, ErrNilTransactionSigner, tb.ApplyUserSignatureWithSigner(nil, &txLocal))
+		assert.Equal(t, ErrNilTransactionSigner, tb.ApplyGuardianSignatureWithSigner(nil, &txLocal))
+		assert.Equal(t, ErrNilTransactionSigner, tb.ApplyRelayerSignatureWithSigner(nil, &txLocal))
+	})
+	t.Run("different guardian and relayer should error", func(t *testing.T) {
+		txLocal := tx
+		txLocal.GuardianAddr = txLocal.Receiver
+		txLocal.RelayerAddr = txLocal.Receiver
+		_ = tb.ApplyUserSignatureWithSigner(txSigner, &txLocal)
+
+		assert.Equal(t, ErrGuardianDoesNotMatch, tb.ApplyGuardianSignatureWithSigner(txSigner, &txLocal))
+		assert.Equal(t, ErrRelayerDoesNotMatch, tb.ApplyRelayerSignatureWithSigner(txSigner, &txLocal))
+	})
+	t.Run("should sign the same as the crypto holder", func(t *testing.T) {
+		signedPayloads = nil
+		txLocal := tx
+		require.Nil(t, tb.ApplyUserSignatureWithSigner(txSigner, &txLocal))
+		require.Nil(t, tb.ApplyGuardianSignatureWithSigner(txSigner, &txLocal))
+		require.Nil(t, tb.ApplyRelayerSignatureWithSigner(txSigner, &txLocal))
+
+		expectedTx := tx
+		require.Nil(t, tb.ApplyUserSignature(cryptoHolder, &expectedTx))
+		require.Nil(t, tb.ApplyGuardianSignature(cryptoHolder, &expectedTx))
+		require.Nil(t, tb.ApplyRelayerSignature(cryptoHolder, &expectedTx))
+		assert.Equal(t, expectedTx, txLocal)
+
+		dataForSigning, _ := tb.ComputeDataForSigning(&txLocal)
+		require.Equal(t, 3, len(signedPayloads))
+		for _, payload := range signedPayloads {
+			assert.Equal(t, dataForSigning, payload)
+		}
+	})
+}
+
+func TestTxBuilder_ComputeDataForSigning(t *testing.T) {
+	t.Parallel()
+
+	tx := &transaction.FrontendTransaction{
+		Nonce:    1,
+		Value:    "11500313000000000000",
+		Receiver: "erd1p72ru5zcdsvgkkcm9swtvw2zy5epylwgv8vwquptkw7ga7pfvk7qz7snzw",
+		Sender:   "erd1lta2vgd0tkeqqadkvgef73y0efs6n3xe5ss589ufhvmt6tcur8kq34qkwr",
+		GasPrice: 1000000000,
+		GasLimit: 60000,
+		ChainID:  "T",
+		Version:  uint32(2),
+	}
+	tb, _ := NewTxBuilder(cryptoProvider.NewSigner())
+
+	t.Run("sign with hash option and old version should error", func(t *testing.T) {
+		t.Parallel()
+
+		txLocal := *tx
+		txLocal.Version = 1
+		txLocal.Options = transaction.MaskSignedWithHash
+
+		dataForSigning, err := tb.ComputeDataForSigning(&txLocal)
+		assert.Nil(t, dataForSigning)
+		assert.True(t, errors.Is(err, ErrInvalidTransactionOptions))
+
+		err = tb.ApplyUserSignature(cryptoHolderFromHex(t, "28654d9264f55f18d810bb88617e22c117df94fa684dfe341a511a72dfbf2b68"), &txLocal)
+		assert.True(t, errors.Is(err, ErrInvalidTransactionOptions))
+		assert.Empty(t, txLocal.Signature)
+	})
+	t.Run("should return the serialized unsigned transaction", func(t *testing.T) {
+		t.Parallel()
+
+		txLocal := *tx
+		txLocal.Signature = "aa"
+		expectedData, _ := json.Marshal(TransactionToUnsignedTx(&txLocal))
+
+		dataForSigning, err := tb.ComputeDataForSigning(&txLocal)
+		assert.Nil(t, err)
+		assert.Equal(t, expectedData, dataForSigning)
+	})
+	t.Run("should return the hash of the serialized unsigned transaction", func(t *testing.T) {
+		t.Parallel()
+
+		txLocal := *tx
+		txLocal.Options = transaction.MaskSignedWithHash
+		serializedTx, _ := json.Marshal(TransactionToUnsignedTx(&txLocal))
+
+		dataForSigning, err := tb.ComputeDataForSigning(&txLocal)
+		assert.Nil(t, err)
+		assert.Equal(t, keccak.NewKeccak().Compute(string(serializedTx)), dataForSigning)
+	})
+	t.Run("all signatures of a hash signed transaction should be over the hash", func(t *testing.T) {
+		t.Parallel()
+
+		cryptoHolder := cryptoHolderFromHex(t, "28654d9264f55f18d810bb88617e22c117df94fa684dfe341a511a72dfbf2b68")
+		cryptoHolderOther := cryptoHolderFromHex(t, "6ae10fed53a84029e53e35afdbe083688eea0917a09a9431951dd42fd4da14c40d248169f4dd7c90537f05be1c49772ddbf8f7948b507ed17fb23284cf218b7d")
+
+		txLocal := *tx
+		txLocal.Options = transaction.MaskSignedWithHash | transaction.MaskGuardedTransaction
+		txLocal.GuardianAddr = cryptoHolderOther.GetBech32()
+		txLocal.RelayerAddr = cryptoHolderOther.GetBech32()
+		require.Nil(t, tb.ApplyUserSignature(cryptoHolder, &txLocal))
+		require.Nil(t, tb.ApplyGuardianSignature(cryptoHolderOther, &txLocal))
+		require.Nil(t, tb.ApplyRelayerSignature(cryptoHolderOther, &txLocal))
+
+		serializedTx, _ := json.Marshal(TransactionToUnsignedTx(&txLocal))
+		txHash := keccak.NewKeccak().Compute(string(serializedTx))
+		verifier := cryptoProvider.NewSigner()
+
+		signature, _ := hex.DecodeString(txLocal.Signature)
+		assert.Nil(t, verifier.VerifyByteSlice(txHash, cryptoHolder.GetPublicKey(), signature))
+		signature, _ = hex.DecodeString(txLocal.GuardianSignature)
+		assert.Nil(t, verifier.VerifyByteSlice(txHash, cryptoHolderOther.GetPublicKey(), signature))
+		signature, _ = hex.DecodeString(txLocal.RelayerSignature)
+		assert.Nil(t, verifier.VerifyByteSlice(txHash, cryptoHolderOther.GetPublicKey(), signature))
+		assert.NotNil(t, verifier.VerifyByteSlice(serializedTx, cryptoHolder.GetPublicKey(), signature))
+	})
+}
+
+func cryptoHolderFromHex(t *testing.T, skHex string) core.CryptoComponentsHolder {
+	sk, err := hex.DecodeString(skHex)
+	require.Nil(t, err)
+	cryptoHolder, err := cryptoProvider.NewCryptoComponentsHolder(keyGen, sk)
+	require.Nil(t, err)
+
+	return cryptoHolder
+}
@@ -0,0 +1,468 @@
+package builders
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	numArgsESDTTransferWithoutCall    = 2
+	numArgsESDTNFTTransferWithoutCall = 4
+	numArgsMultiTransferHeader        = 2
+	numArgsPerMultiTransfer           = 3
+	numArgsSetGuardian                = 2
+	numArgsRelayedTx                  = 1
+	numArgsRelayedTxV2                = 4
+)
+
+var builtInFunctions = map[string]struct{}{
+	core.BuiltInFunctionClaimDeveloperRewards:     {},
+	core.BuiltInFunctionChangeOwnerAddress:        {},
+	core.BuiltInFunctionSetUserName:               {},
+	core.BuiltInFunctionSaveKeyValue:              {},
+	core.BuiltInFunctionESDTTransfer:              {},
+	core.BuiltInFunctionESDTBurn:                  {},
+	core.BuiltInFunctionESDTFreeze:                {},
+	core.BuiltInFunctionESDTUnFreeze:              {},
+	core.BuiltInFunctionESDTWipe:                  {},
+	core.BuiltInFunctionESDTPause:                 {},
+	core.BuiltInFunctionESDTUnPause:               {},
+	core.BuiltInFunctionSetESDTRole:               {},
+	core.BuiltInFunctionUnSetESDTRole:             {},
+	core.BuiltInFunctionESDTSetLimitedTransfer:    {},
+	core.BuiltInFunctionESDTUnSetLimitedTransfer:  {},
+	core.BuiltInFunctionESDTLocalMint:             {},
+	core.BuiltInFunctionESDTLocalBurn:             {},
+	core.BuiltInFunctionESDTNFTTransfer:           {},
+	core.BuiltInFunctionESDTNFTCreate:             {},
+	core.BuiltInFunctionESDTNFTAddQuantity:        {},
+	core.BuiltInFunctionESDTNFTCreateRoleTransfer: {},
+	core.BuiltInFunctionESDTNFTBurn:               {},
+	core.BuiltInFunctionESDTNFTAddURI:             {},
+	core.BuiltInFunctionESDTNFTUpdateAttributes:   {},
+	core.BuiltInFunctionMultiESDTNFTTransfer:      {},
+	core.BuiltInFunctionSetGuardian:               {},
+	core.BuiltInFunctionGuardAccount:              {},
+	core.BuiltInFunctionUnGuardAccount:            {},
+	core.BuiltInFunctionMigrateDataTrie:           {},
+}
+
+// KeyValuePair holds a key-value pair saved in the account's storage through the SaveKeyValue built-in function
+type KeyValuePair struct {
+	Key   []byte
+	Value []byte
+}
+
+// ParsedTxData is the structured form of a transaction's data field. Function and Args are set for the function calls
+// (the arguments being hex decoded), while the other fields are set only for the recognized built-in functions and
+// relayed transactions
+type ParsedTxData struct {
+	Function          string
+	Args              [][]byte
+	IsBuiltInFunction bool
+
+	// RawData is set, instead of Function and Args, when the data field is not a function call, such as a plain text
+	// note ("thanks @bob") attached to a move balance
+	RawData []byte
+
+	// TokenTransfers and TransferReceiver are set for the ESDTTransfer, ESDTNFTTransfer and MultiESDTNFTTransfer
+	// functions. The receiver of an ESDTTransfer is the transaction's receiver, so it is set only by ParseTransaction
+	TokenTransfers   []*TokenTransfer
+	TransferReceiver string
+	// ContractCall is the smart contract call executed after the token transfer, if any
+	ContractCall *ParsedTxData
+
+	// InnerTransaction is set for the relayedTx and relayedTxV2 functions. The sender of a relayedTxV2 inner
+	// transaction is the relayed transaction's receiver, so it is set only by ParseTransaction
+	InnerTransaction     *transaction.FrontendTransaction
+	InnerTransactionData *ParsedTxData
+
+	// Guardian and ServiceUID are set for the SetGuardian function
+	Guardian   string
+	ServiceUID string
+
+	// KeyValuePairs is set for the SaveKeyValue function
+	KeyValuePairs []*KeyValuePair
+}
+
+// ArgAsString returns the argument at the provided index as string
+func (parsed *ParsedTxData) ArgAsString(index int) (string, error) {
+	arg, err := parsed.getArg(index)
+	if err != nil {
+		return "", err
+	}
+
+	return string(arg), nil
+}
+
+// ArgAsBigInt returns the argument at the provided index as an unsigned big integer
+func (parsed *ParsedTxData) ArgAsBigInt(index int) (*big.Int, error) {
+	arg, err := parsed.getArg(index)
+	if err != nil {
+		return nil, err
+	}
+
+	return big.NewInt(0).SetBytes(arg), nil
+}
+
+// ArgAsAddress returns the argument at the provided index as address
+func (parsed *ParsedTxData) ArgAsAddress(index int) (sdkCore.AddressHandler, error) {
+	arg, err := parsed.getArg(index)
+	if err != nil {
+		return nil, err
+	}
+
+	address := data.NewAddressFromBytes(arg)
+	if !address.IsValid() {
+		return nil, fmt.Errorf("%w: argument %d is not an address", ErrInvalidTxData, index)
+	}
+
+	return address, nil
+}
+
+func (parsed *ParsedTxData) getArg(index int) ([]byte, error) {
+	if index < 0 || index >= len(parsed.Args) {
+		return nil, fmt.Errorf("%w: argument %d not found, the data field has %d arguments", ErrInvalidTxData, index, len(parsed.Args))
+	}
+
+	return parsed.Args[index], nil
+}
+
+// txDataParser is the reverse of the txDataBuilder: splits a transaction's data field into the function and its
+// arguments and decodes the built-in functions and the relayed transactions into structured values
+type txDataParser struct {
+	addressConverter sdkCore.AddressConverter
+}
+
+// NewTxDataParser creates a new transaction data parser
+func NewTxDataParser() *txDataParser {
+	return NewTxDataParserWithAddressConverter(sdkCore.AddressPublicKeyConverter)
+}
+
+// NewTxDataParserWithAddressConverter creates a new transaction data parser that encodes the decoded addresses using
+// the provided address converter, for the chains that do not use the default address prefix. A nil converter means
+// the default one
+func NewTxDataParserWithAddressConverter(addressConverter sdkCore.AddressConverter) *txDataParser {
+	return &txDataParser{
+		addressConverter: sdkCore.AddressConverterOrDefault(addressConverter),
+	}
+}
+
+// ParseTransaction parses the data field of a transaction, as returned in hyperblocks. The fields that depend on the
+// transaction (the ESDTTransfer receiver and the relayedTxV2 inner transaction's sender and gas price) are completed
+func (parser *txDataParser) ParseTransaction(tx *data.TransactionOnNetwork) (*ParsedTxData, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+
+	parsed, err := parser.Parse(tx.Data)
+	if err != nil {
+		return nil, err
+	}
+
+	switch parsed.Function {
+	case core.BuiltInFunctionESDTTransfer:
+		parsed.TransferReceiver = tx.Receiver
+	case core.RelayedTransactionV2:
+		parsed.InnerTransaction.Sender = tx.Receiver
+		parsed.InnerTransaction.GasPrice = tx.GasPrice
+	}
+
+	return parsed, nil
+}
+
+// Parse parses the provided data field. An empty data field (a simple move balance) results in an empty function and
+// a data field that is not a function call is returned unparsed, in the RawData field
+func (parser *txDataParser) Parse(txData []byte) (*ParsedTxData, error) {
+	if len(txData) == 0 {
+		return &ParsedTxData{}, nil
+	}
+
+	parsed, ok := splitTxData(txData)
+	if !ok {
+		return &ParsedTxData{
+			RawData: txData,
+		}, nil
+	}
+
+	var err error
+	_, parsed.IsBuiltInFunction = builtInFunctions[parsed.Function]
+	switch parsed.Function {
+	case core.BuiltInFunctionESDTTransfer:
+		err = parser.parseESDTTransfer(parsed)
+	case core.BuiltInFunctionESDTNFTTransfer:
+		err = parser.parseESDTNFTTransfer(parsed)
+	case core.BuiltInFunctionMultiESDTNFTTransfer:
+		err = parser.parseMultiESDTNFTTransfer(parsed)
+	case core.BuiltInFunctionSetGuardian:
+		err = parser.parseSetGuardian(parsed)
+	case core.BuiltInFunctionSaveKeyValue:
+		err = parser.parseSaveKeyValue(parsed)
+	case core.RelayedTransaction:
+		err = parser.parseRelayedTx(parsed)
+	case core.RelayedTransactionV2:
+		err = parser.parseRelayedTxV2(parsed)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("%w for %s", err, parsed.Function)
+	}
+
+	return parsed, nil
+}
+
+// splitTxData splits the data field into the function and its hex decoded arguments. Returns false if the data field
+// is not a function call (missing function or not hex encoded arguments)
+func splitTxData(txData []byte) (*ParsedTxData, bool) {
+	tokens := strings.Split(string(txData), dataSeparator)
+	if len(tokens[0]) == 0 {
+		return nil, false
+	}
+
+	args := make([][]byte, 0, len(tokens)-1)
+	for _, token := range tokens[1:] {
+		arg, err := hex.DecodeString(token)
+		if err != nil {
+			return nil, false
+		}
+
+		args = append(args, arg)
+	}
+
+	return &ParsedTxData{
+		Function: tokens[0],
+		Args:     args,
+	}, true
+}
+
+// ESDTTransfer@token@amount[@function@args...]
+func (parser *txDataParser) parseESDTTransfer(parsed *ParsedTxData) error {
+	if len(parsed.Args) < numArgsESDTTransferWithoutCall {
+		return fmt.Errorf("%w: expected at least %d arguments", ErrInvalidTxData, numArgsESDTTransferWithoutCall)
+	}
+
+	parsed.TokenTransfers = []*TokenTransfer{
+		{
+			TokenIdentifier: string(parsed.Args[0]),
+			Amount:          big.NewInt(0).SetBytes(parsed.Args[1]),
+		},
+	}
+	parsed.ContractCall = createNestedContractCall(parsed.Args[numArgsESDTTransferWithoutCall:])
+
+	return nil
+}
+
+// ESDTNFTTransfer@token@nonce@amount@receiver[@function@args...]
+func (parser *txDataParser) parseESDTNFTTransfer(parsed *ParsedTxData) error {
+	if len(parsed.Args) < numArgsESDTNFTTransferWithoutCall {
+		return fmt.Errorf("%w: expected at least %d arguments", ErrInvalidTxData, numArgsESDTNFTTransferWithoutCall)
+	}
+
+	receiver, err := parser.bech32ArgAt(parsed, 3)
+	if err != nil {
+		return err
+	}
+
+	parsed.TokenTransfers = []*TokenTransfer{
+		{
+			TokenIdentifier: string(parsed.Args[0]),
+			Nonce:           big.NewInt(0).SetBytes(parsed.Args[1]).Uint64(),
+			Amount:          big.NewInt(0).SetBytes(parsed.Args[2]),
+		},
+	}
+	parsed.TransferReceiver = receiver
+	parsed.ContractCall = createNestedContractCall(parsed.Args[numArgsESDTNFTTransferWithoutCall:])
+
+	return nil
+}
+
+// MultiESDTNFTTransfer@receiver@numTransfers[@token@nonce@amount]...[@function@args...]
+func (parser *txDataParser) parseMultiESDTNFTTransfer(parsed *ParsedTxData) error {
+	if len(parsed.Args) < numArgsMultiTransferHeader {
+		return fmt.Errorf("%w: expected at least %d arguments", ErrInvalidTxData, numArgsMultiTransferHeader)
+	}
+
+	receiver, err := parser.bech32ArgAt(parsed, 0)
+	if err != nil {
+		return err
+	}
+
+	numTransfers := big.NewInt(0).SetBytes(parsed.Args[1])
+	maxTransfers := (len(parsed.Args) - numArgsMultiTransferHeader) / numArgsPerMultiTransfer
+	if numTransfers.Sign() == 0 || numTransfers.Cmp(big.NewInt(int64(maxTransfers))) > 0 {
+		return fmt.Errorf("%w: invalid number of transfers %s", ErrInvalidTxData, numTransfers.String())
+	}
+
+	parsed.TransferReceiver = receiver
+	parsed.TokenTransfers = make([]*TokenTransfer, 0, numTransfers.Uint64())
+	index := numArgsMultiTransferHeader
+	for i := uint64(0); i < numTransfers.Uint64(); i++ {
+		parsed.TokenTransfers = append(parsed.TokenTransfers, &TokenTransfer{
+			TokenIdentifier: string(parsed.Args[index]),
+			Nonce:           big.NewInt(0).SetBytes(parsed.Args[index+1]).Uint64(),
+			Amount:          big.NewInt(0).SetBytes(parsed.Args[index+2]),
+		})
+		index += numArgsPerMultiTransfer
+	}
+	parsed.ContractCall = createNestedContractCall(parsed.Args[index:])
+
+	return nil
+}
+
+// SetGuardian@guardian@serviceUID
+func (parser *txDataParser) parseSetGuardian(parsed *ParsedTxData) error {
+	if len(parsed.Args) != numArgsSetGuardian {
+		return fmt.Errorf("%w: expected %d arguments", ErrInvalidTxData, numArgsSetGuardian)
+	}
+
+	guardian, err := parser.bech32ArgAt(parsed, 0)
+	if err != nil {
+		return err
+	}
+
+	parsed.Guardian = guardian
+	parsed.ServiceUID = string(parsed.Args[1])
+
+	return nil
+}
+
+// SaveKeyValue@key@value[@key@value]...
+func (parser *txDataParser) parseSaveKeyValue(parsed *ParsedTxData) error {
+	if len(parsed.Args) == 0 || len(parsed.Args)%2 != 0 {
+		return fmt.Errorf("%w: expected key-value pairs", ErrInvalidTxData)
+	}
+
+	parsed.KeyValuePairs = make([]*KeyValuePair, 0, len(parsed.Args)/2)
+	for i := 0; i < len(parsed.Args); i += 2 {
+		parsed.KeyValuePairs = append(parsed.KeyValuePairs, &KeyValuePair{
+			Key:   parsed.Args[i],
+			Value: parsed.Args[i+1],
+		})
+	}
+
+	return nil
+}
+
+// relayedTx@json(innerTx)
+func (parser *txDataParser) parseRelayedTx(parsed *ParsedTxData) error {
+	if len(parsed.Args) != numArgsRelayedTx {
+		return fmt.Errorf("%w: expected %d argument", ErrInvalidTxData, numArgsRelayedTx)
+	}
+
+	coreTx := &transaction.Transaction{}
+	err := json.Unmarshal(parsed.Args[0], coreTx)
+	if err != nil {
+		return fmt.Errorf("%w: invalid inner transaction, %s", ErrInvalidTxData, err.Error())
+	}
+
+	innerTx, err := nodeTransactionToFrontendTransaction(coreTx, parser.addressConverter)
+	if err != nil {
+		return err
+	}
+
+	return parser.setInnerTransaction(parsed, innerTx)
+}
+
+// relayedTxV2@receiver@nonce@data@signature
+func (parser *txDataParser) parseRelayedTxV2(parsed *ParsedTxData) error {
+	if len(parsed.Args) != numArgsRelayedTxV2 {
+		return fmt.Errorf("%w: expected %d arguments", ErrInvalidTxData, numArgsRelayedTxV2)
+	}
+
+	receiver, err := parser.bech32ArgAt(parsed, 0)
+	if err != nil {
+		return err
+	}
+
+	innerTx := &transaction.FrontendTransaction{
+		Nonce:     big.NewInt(0).SetBytes(parsed.Args[1]).Uint64(),
+		Value:     "0",
+		Receiver:  receiver,
+		Data:      parsed.Args[2],
+		Signature: hex.EncodeToString(parsed.Args[3]),
+	}
+
+	return parser.setInnerTransaction(parsed, innerTx)
+}
+
+func (parser *txDataParser) setInnerTransaction(parsed *ParsedTxData, innerTx *transaction.FrontendTransaction) error {
+	innerFunction, _, _ := strings.Cut(string(innerTx.Data), dataSeparator)
+	if innerFunction == core.RelayedTransaction || innerFunction == core.RelayedTransactionV2 {
+		return ErrNestedRelayedTransaction
+	}
+
+	innerData, err := parser.Parse(innerTx.Data)
+	if err != nil {
+		return fmt.Errorf("%w in the inner transaction", err)
+	}
+
+	parsed.InnerTransaction = innerTx
+	parsed.InnerTransactionData = innerData
+
+	return nil
+}
+
+func (parser *txDataParser) bech32ArgAt(parsed *ParsedTxData, index int) (string, error) {
+	address, err := parsed.ArgAsAddress(index)
+	if err != nil {
+		return "", err
+	}
+
+	return parser.addressConverter.Encode(address.AddressBytes())
+}
+
+func createNestedContractCall(args [][]byte) *ParsedTxData {
+	if len(args) == 0 {
+		return nil
+	}
+
+	return &ParsedTxData{
+		Function: string(args[0]),
+		Args:     args[1:],
+	}
+}
+
+func nodeTransactionToFrontendTransaction(
+	tx *transaction.Transaction,
+	addressConverter sdkCore.AddressConverter,
+) (*transaction.FrontendTransaction, error) {
+	receiver, err := addressConverter.Encode(tx.RcvAddr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid inner transaction receiver", ErrInvalidTxData)
+	}
+	sender, err := addressConverter.Encode(tx.SndAddr)
+	if err != nil {
+		return nil, fmt.Errorf("%w: invalid inner transaction sender", ErrInvalidTxData)
+	}
+
+	value := "0"
+	if tx.Value != nil {
+		value = tx.Value.String()
+	}
+
+	return &transaction.FrontendTransaction{
+		Nonce:     tx.Nonce,
+		Value:     value,
+		Receiver:  receiver,
+		Sender:    sender,
+		GasPrice:  tx.GasPrice,
+		GasLimit:  tx.GasLimit,
+		Data:      tx.Data,
+		Signature: hex.EncodeToString(tx.Signature),
+		ChainID:   string(tx.ChainID),
+		Version:   tx.Version,
+		Options:   tx.Options,
+	}, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (parser *txDataParser) IsInterfaceNil() bool {
+	return parser == nil
+}
@@ -0,0 +1,321 @@
+package builders
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewTxDataParser(t *testing.T) {
+	t.Parallel()
+
+	parser := NewTxDataParser()
+	assert.False(t, check.IfNil(parser))
+}
+
+func TestTxDataParser_Parse(t *testing.T) {
+	t.Parallel()
+
+	parser := NewTxDataParser()
+	receiver, _ := data.NewAddressFromBech32String(testTokenReceiver)
+
+	t.Run("empty data should work", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := parser.Parse(nil)
+		assert.Nil(t, err)
+		assert.Equal(t, &ParsedTxData{}, parsed)
+	})
+	t.Run("missing function should return the raw data", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := parser.Parse([]byte("@01"))
+		assert.Nil(t, err)
+		assert.Equal(t, &ParsedTxData{RawData: []byte("@01")}, parsed)
+	})
+	t.Run("not hex encoded argument should return the raw data", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := parser.Parse([]byte("function@01@zz"))
+		assert.Nil(t, err)
+		assert.Equal(t, &ParsedTxData{RawData: []byte("function@01@zz")}, parsed)
+	})
+	t.Run("plain text with separator should return the raw data", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := parser.Parse([]byte("thanks @bob"))
+		assert.Nil(t, err)
+		assert.Equal(t, &ParsedTxData{RawData: []byte("thanks @bob")}, parsed)
+	})
+	t.Run("smart contract call should work", func(t *testing.T) {
+		t.Parallel()
+
+		txData, _ := NewTxDataBuilder().Function("claim").ArgBigInt(big.NewInt(15)).ArgAddress(receiver).ArgBytes([]byte("abc")).ToDataBytes()
+		parsed, err := parser.Parse(txData)
+		require.Nil(t, err)
+		assert.Equal(t, "claim", parsed.Function)
+		assert.False(t, parsed.IsBuiltInFunction)
+		require.Equal(t, 3, len(parsed.Args))
+
+		value, err := parsed.ArgAsBigInt(0)
+		assert.Nil(t, err)
+		assert.Equal(t, big.NewInt(15), value)
+		address, err := parsed.ArgAsAddress(1)
+		assert.Nil(t, err)
+		assert.Equal(t, receiver.AddressBytes(), address.AddressBytes())
+		str, err := parsed.ArgAsString(2)
+		assert.Nil(t, err)
+		assert.Equal(t, "abc", str)
+
+		_, err = parsed.ArgAsAddress(0)
+		assert.True(t, errors.Is(err, ErrInvalidTxData))
+		_, err = parsed.ArgAsString(3)
+		assert.True(t, errors.Is(err, ErrInvalidTxData))
+	})
+	t.Run("ESDTTransfer should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := NewTokenTransferBuilder().
+			SetSenderAccount(&data.Account{Address: testTokenSender}).
+			SetReceiver(testTokenReceiver).
+			SetNetworkConfig(createTokenTransferNetworkConfig()).
+			AddTokenTransfer("USDC-c76f1f", 0, big.NewInt(1000)).
+			SetContractCall("deposit", []byte{1}).
+			SetGasLimitForContractCall(1000000).
+			Build()
+		require.Nil(t, err)
+
+		parsed, err := parser.Parse(tx.Data)
+		require.Nil(t, err)
+		assert.Equal(t, core.BuiltInFunctionESDTTransfer, parsed.Function)
+		assert.True(t, parsed.IsBuiltInFunction)
+		assert.Equal(t, []*TokenTransfer{{TokenIdentifier: "USDC-c76f1f", Amount: big.NewInt(1000)}}, parsed.TokenTransfers)
+		assert.Empty(t, parsed.TransferReceiver)
+		assert.Equal(t, &ParsedTxData{Function: "deposit", Args: [][]byte{{1}}}, parsed.ContractCall)
+	})
+	t.Run("ESDTTransfer with missing arguments should error", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := parser.Parse([]byte("ESDTTransfer@555344432d633736663166"))
+		assert.Nil(t, parsed)
+		assert.True(t, errors.Is(err, ErrInvalidTxData))
+	})
+	t.Run("ESDTNFTTransfer should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := NewTokenTransferBuilder().
+			SetSenderAccount(&data.Account{Address: testTokenSender}).
+			SetReceiver(testTokenReceiver).
+			SetNetworkConfig(createTokenTransferNetworkConfig()).
+			AddTokenTransfer("NFT-123456", 7, big.NewInt(1)).
+			Build()
+		require.Nil(t, err)
+
+		parsed, err := parser.Parse(tx.Data)
+		require.Nil(t, err)
+		assert.Equal(t, core.BuiltInFunctionESDTNFTTransfer, parsed.Function)
+		assert.Equal(t, []*TokenTransfer{{TokenIdentifier: "NFT-123456", Nonce: 7, Amount: big.NewInt(1)}}, parsed.TokenTransfers)
+		assert.Equal(t, testTokenReceiver, parsed.TransferReceiver)
+		assert.Nil(t, parsed.ContractCall)
+	})
+	t.Run("ESDTNFTTransfer with invalid receiver should error", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := parser.Parse([]byte("ESDTNFTTransfer@4e46542d313233343536@07@01@aabb"))
+		assert.Nil(t, parsed)
+		assert.True(t, errors.Is(err, ErrInvalidTxData))
+	})
+	t.Run("MultiESDTNFTTransfer should work", func(t *testing.T) {
+		t.Parallel()
+
+		tx, err := NewTokenTransferBuilder().
+			SetSenderAccount(&data.Account{Address: testTokenSender}).
+			SetReceiver(testTokenReceiver).
+			SetNetworkConfig(createTokenTransferNetworkConfig()).
+			AddTokenTransfer("USDC-c76f1f", 0, big.NewInt(1000)).
+			AddTokenTransfer("NFT-123456", 7, big.NewInt(1)).
+			SetContractCall("addLiquidity").
+			SetGasLimitForContractCall(1000000).
+			Build()
+		require.Nil(t, err)
+
+		parsed, err := parser.Parse(tx.Data)
+		require.Nil(t, err)
+		assert.Equal(t, core.BuiltInFunctionMultiESDTNFTTransfer, parsed.Function)
+		expectedTransfers := []*TokenTransfer{
+			{TokenIdentifier: "USDC-c76f1f", Amount: big.NewInt(1000)},
+			{TokenIdentifier: "NFT-123456", Nonce: 7, Amount: big.NewInt(1)},
+		}
+		assert.Equal(t, expectedTransfers, parsed.TokenTransfers)
+		assert.Equal(t, testTokenReceiver, parsed.TransferReceiver)
+		assert.Equal(t, &ParsedTxData{Function: "addLiquidity", Args: [][]byte{}}, parsed.ContractCall)
+	})
+	t.Run("MultiESDTNFTTransfer with too many transfers should error", func(t *testing.T) {
+		t.Parallel()
+
+		txData, _ := NewTxDataBuilder().
+			Function(core.BuiltInFunctionMultiESDTNFTTransfer).
+			ArgAddress(receiver).
+			ArgInt64(2).
+			ArgBytes([]byte("USDC-c76f1f")).ArgInt64(0).ArgInt64(1000).
+			ToDataBytes()
+		parsed, err := parser.Parse(txData)
+		assert.Nil(t, parsed)
+		assert.True(t, errors.Is(err, ErrInvalidTxData))
+	})
+	t.Run("SetGuardian should work", func(t *testing.T) {
+		t.Parallel()
+
+		builder, _ := NewGuardianBuilder(createTokenTransferNetworkConfig())
+		tx, err := builder.SetGuardian(&data.Account{Address: testTokenSender}, testGuardian, "uuid")
+		require.Nil(t, err)
+
+		parsed, err := parser.Parse(tx.Data)
+		require.Nil(t, err)
+		assert.Equal(t, core.BuiltInFunctionSetGuardian, parsed.Function)
+		assert.Equal(t, testGuardian, parsed.Guardian)
+		assert.Equal(t, "uuid", parsed.ServiceUID)
+	})
+	t.Run("SaveKeyValue should work", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := parser.Parse([]byte("SaveKeyValue@6b6579@76616c7565@6b657932@"))
+		require.Nil(t, err)
+		expectedPairs := []*KeyValuePair{
+			{Key: []byte("key"), Value: []byte("value")},
+			{Key: []byte("key2"), Value: []byte{}},
+		}
+		assert.Equal(t, expectedPairs, parsed.KeyValuePairs)
+	})
+	t.Run("SaveKeyValue without value should error", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := parser.Parse([]byte("SaveKeyValue@6b6579"))
+		assert.Nil(t, parsed)
+		assert.True(t, errors.Is(err, ErrInvalidTxData))
+	})
+	t.Run("other built-in function should work", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := parser.Parse([]byte("ESDTLocalMint@555344432d633736663166@64"))
+		require.Nil(t, err)
+		assert.True(t, parsed.IsBuiltInFunction)
+		assert.Equal(t, 2, len(parsed.Args))
+	})
+	t.Run("relayedTx should work", func(t *testing.T) {
+		t.Parallel()
+
+		innerTx := &transaction.FrontendTransaction{
+			Nonce:     5,
+			Value:     "100",
+			Receiver:  testTokenReceiver,
+			Sender:    testTokenSender,
+			GasPrice:  1000000000,
+			GasLimit:  60000000,
+			Data:      []byte("claim@01"),
+			Signature: "aabbcc",
+			ChainID:   "T",
+			Version:   1,
+		}
+		innerTxHex, err := prepareInnerTxForRelayV1(innerTx, sdkCore.AddressPublicKeyConverter)
+		require.Nil(t, err)
+
+		parsed, err := parser.Parse([]byte(core.RelayedTransaction + "@" + innerTxHex))
+		require.Nil(t, err)
+		assert.Equal(t, innerTx, parsed.InnerTransaction)
+		assert.Equal(t, &ParsedTxData{Function: "claim", Args: [][]byte{{1}}}, parsed.InnerTransactionData)
+	})
+	t.Run("relayedTx with invalid inner transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := parser.Parse([]byte(core.RelayedTransaction + "@7b7d7d"))
+		assert.Nil(t, parsed)
+		assert.True(t, errors.Is(err, ErrInvalidTxData))
+	})
+	t.Run("relayedTxV2 should work", func(t *testing.T) {
+		t.Parallel()
+
+		innerTx := &transaction.FrontendTransaction{
+			Nonce:     5,
+			Receiver:  testTokenReceiver,
+			Data:      []byte("claim"),
+			Signature: "aabbcc",
+		}
+		innerTxHex, err := prepareInnerTxForRelayV2(innerTx, sdkCore.AddressPublicKeyConverter)
+		require.Nil(t, err)
+
+		parsed, err := parser.Parse([]byte(core.RelayedTransactionV2 + "@" + innerTxHex))
+		require.Nil(t, err)
+		assert.Equal(t, uint64(5), parsed.InnerTransaction.Nonce)
+		assert.Equal(t, testTokenReceiver, parsed.InnerTransaction.Receiver)
+		assert.Equal(t, []byte("claim"), parsed.InnerTransaction.Data)
+		assert.Equal(t, "aabbcc", parsed.InnerTransaction.Signature)
+		assert.Equal(t, "claim", parsed.InnerTransactionData.Function)
+	})
+	t.Run("nested relayed transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		innerTx := &transaction.FrontendTransaction{
+			Receiver:  testTokenReceiver,
+			Data:      []byte(core.RelayedTransactionV2 + "@00@00@00@00"),
+			Signature: "aabbcc",
+		}
+		innerTxHex, _ := prepareInnerTxForRelayV2(innerTx, sdkCore.AddressPublicKeyConverter)
+
+		parsed, err := parser.Parse([]byte(core.RelayedTransactionV2 + "@" + innerTxHex))
+		assert.Nil(t, parsed)
+		assert.True(t, errors.Is(err, ErrNestedRelayedTransaction))
+	})
+}
+
+func TestTxDataParser_ParseTransaction(t *testing.T) {
+	t.Parallel()
+
+	parser := NewTxDataParser()
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		parsed, err := parser.ParseTransaction(nil)
+		assert.Nil(t, parsed)
+		assert.Equal(t, ErrNilTransaction, err)
+	})
+	t.Run("ESDTTransfer receiver should be set", func(t *testing.T) {
+		t.Parallel()
+
+		tx := &data.TransactionOnNetwork{
+			Receiver: testTokenReceiver,
+			Data:     []byte("ESDTTransfer@555344432d633736663166@03e8"),
+		}
+		parsed, err := parser.ParseTransaction(tx)
+		require.Nil(t, err)
+		assert.Equal(t, testTokenReceiver, parsed.TransferReceiver)
+	})
+	t.Run("relayedTxV2 inner sender should be set", func(t *testing.T) {
+		t.Parallel()
+
+		innerTx := &transaction.FrontendTransaction{
+			Receiver:  testTokenReceiver,
+			Data:      []byte("claim"),
+			Signature: "aabbcc",
+		}
+		innerTxHex, _ := prepareInnerTxForRelayV2(innerTx, sdkCore.AddressPublicKeyConverter)
+		tx := &data.TransactionOnNetwork{
+			Receiver: testTokenSender,
+			GasPrice: 1000000000,
+			Data:     []byte(core.RelayedTransactionV2 + "@" + innerTxHex),
+		}
+		parsed, err := parser.ParseTransaction(tx)
+		require.Nil(t, err)
+		assert.Equal(t, testTokenSender, parsed.InnerTransaction.Sender)
+		assert.Equal(t, uint64(1000000000), parsed.InnerTransaction.GasPrice)
+	})
+}
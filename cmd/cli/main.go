@@ -7,6 +7,7 @@ import (
 	"os"
 	"time"
 
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
@@ -161,9 +162,14 @@ type selectedOptions struct {
 	relayerCryptoHolder  core.CryptoComponentsHolder
 
 	guardianAddress core.AddressHandler
+	relayerAddress  core.AddressHandler
 	tx              transaction.FrontendTransaction
 }
 
+type moveBalanceGasEstimator interface {
+	ComputeMoveBalanceGasLimit(tx *transaction.FrontendTransaction) uint64
+}
+
 type testData struct {
 	skFunding      []byte
 	skAlice        []byte
@@ -230,7 +236,17 @@ func process() error {
 		return err
 	}
 
-	options, err := processCommand(td, ep, netConfigs)
+	gasEstimator, err := interactors.NewGasEstimator(interactors.ArgsGasEstimator{
+		NetworkConfig:       netConfigs,
+		CostRequester:       ep,
+		SafetyMarginPercent: interactors.DefaultGasSafetyMarginPercent,
+	})
+	if err != nil {
+		log.Error("error creating gas estimator", "error", err)
+		return err
+	}
+
+	options, err := processCommand(td, ep, netConfigs, gasEstimator)
 	if err != nil {
 		return err
 	}
@@ -245,7 +261,12 @@ func process() error {
 	return generateAndSendTransaction(options, ep)
 }
 
-func processCommand(td *testData, proxy workflows.ProxyHandler, config *data.NetworkConfig) (*selectedOptions, error) {
+func processCommand(
+	td *testData,
+	proxy workflows.ProxyHandler,
+	config *data.NetworkConfig,
+	gasEstimator moveBalanceGasEstimator,
+) (*selectedOptions, error) {
 	options, err := getDefaultOptions(td, proxy, config)
 	if err != nil {
 		return nil, err
@@ -276,7 +297,7 @@ func processCommand(td *testData, proxy workflows.ProxyHandler, config *data.Net
 		return nil, err
 	}
 
-	err = setOtherOptions(options, config)
+	err = setOtherOptions(options, config, gasEstimator)
 	if err != nil {
 		return nil, err
 	}
@@ -360,11 +381,7 @@ func setRelayedTxByOption(td *testData, options *selectedOptions) error {
 	}
 
 	if selectedAddress != nil {
-		options.tx.RelayerAddr, err = selectedAddress.AddressAsBech32String()
-		if err != nil {
-			return err
-		}
-
+		options.relayerAddress = selectedAddress
 		options.relayerCryptoHolder, err = cryptoProvider.NewCryptoComponentsHolder(keyGen, sk)
 		if err != nil {
 			return err
@@ -404,12 +421,12 @@ func selectAddressAndSkFromString(td *testData, option string) (core.AddressHand
 	return selectedAddress, sk, nil
 }
 
-func setOtherOptions(options *selectedOptions, config *data.NetworkConfig) error {
+func setOtherOptions(options *selectedOptions, config *data.NetworkConfig, gasEstimator moveBalanceGasEstimator) error {
 	if len(argsConfig.guardian) > 0 && len(argsConfig.dataField) > 0 {
 		return errors.New("dataField and setGuardian cannot be set together")
 	}
 
-	err := treatDataIfNeeded(options, config)
+	err := treatDataIfNeeded(options, gasEstimator)
 	if err != nil {
 		return err
 	}
@@ -418,24 +435,53 @@ func setOtherOptions(options *selectedOptions, config *data.NetworkConfig) error
 		options.tx.Value = argsConfig.value
 	}
 
+	return createRelayedTxV3IfNeeded(options, config)
+}
+
+func createRelayedTxV3IfNeeded(options *selectedOptions, config *data.NetworkConfig) error {
+	if check.IfNil(options.relayerAddress) {
+		return nil
+	}
+
+	relayerAddress, err := options.relayerAddress.AddressAsBech32String()
+	if err != nil {
+		return err
+	}
+
+	relayedTx, err := builders.NewRelayedTxV3Builder().
+		SetInnerTransaction(&options.tx).
+		SetRelayerAccount(&data.Account{Address: relayerAddress}).
+		SetNetworkConfig(config).
+		Build()
+	if err != nil {
+		return err
+	}
+	if argsConfig.gasLimit != 0 {
+		// the relaying gas is only added on top of the estimated gas limit, an explicit one is used as provided
+		relayedTx.GasLimit = argsConfig.gasLimit
+	}
+
+	options.tx = *relayedTx
+
 	return nil
 }
 
-func treatDataIfNeeded(options *selectedOptions, config *data.NetworkConfig) error {
+func treatDataIfNeeded(options *selectedOptions, gasEstimator moveBalanceGasEstimator) error {
 	var err error
+	builtInFunctionGasCost := uint64(0)
 	if len(argsConfig.guardian) > 0 {
 		options.tx.Data, err = createSetGuardianData(options.guardianAddress)
 		if err != nil {
 			return err
 		}
-		options.tx.GasLimit += setGuardianGasCost
+		builtInFunctionGasCost = setGuardianGasCost
 		options.tx.Receiver = options.tx.Sender
 	}
 	if len(argsConfig.dataField) > 0 {
 		options.tx.Data = []byte(argsConfig.dataField)
 	}
 	options.tx.Version = 2
-	options.tx.GasLimit += uint64(len(options.tx.Data)) * config.GasPerDataByte
+	options.tx.GasLimit = gasEstimator.ComputeMoveBalanceGasLimit(&options.tx) + builtInFunctionGasCost
 	if argsConfig.gasLimit != 0 {
 		options.tx.GasLimit = argsConfig.gasLimit
 	}
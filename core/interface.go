@@ -1,6 +1,9 @@
 package core
 
-import crypto "github.com/multiversx/mx-chain-crypto-go"
+import (
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+)
 
 // AddressHandler will handle different implementations of an address
 type AddressHandler interface {
@@ -12,6 +15,13 @@ type AddressHandler interface {
 	IsInterfaceNil() bool
 }
 
+// AddressConverter is able to convert the addresses between their raw bytes and their bech32 representations
+type AddressConverter interface {
+	Decode(humanReadable string) ([]byte, error)
+	Encode(pkBytes []byte) (string, error)
+	IsInterfaceNil() bool
+}
+
 // CryptoComponentsHolder is able to holder and provide all the crypto components
 type CryptoComponentsHolder interface {
 	GetPublicKey() crypto.PublicKey
@@ -20,3 +30,13 @@ type CryptoComponentsHolder interface {
 	GetAddressHandler() AddressHandler
 	IsInterfaceNil() bool
 }
+
+// TransactionSigner is able to sign transactions and messages on behalf of an address without exposing its private
+// key, so the key can be held by a separate signing service, a KMS or a hardware wallet
+type TransactionSigner interface {
+	GetBech32() string
+	GetAddressHandler() AddressHandler
+	SignTransaction(tx *transaction.FrontendTransaction, dataForSigning []byte) ([]byte, error)
+	SignMessage(message []byte) ([]byte, error)
+	IsInterfaceNil() bool
+}
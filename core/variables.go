@@ -2,8 +2,24 @@ package core
 
 import (
 	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
 	"github.com/multiversx/mx-chain-core-go/core/pubkeyConverter"
 )
 
 // AddressPublicKeyConverter represents the default address public key converter
 var AddressPublicKeyConverter, _ = pubkeyConverter.NewBech32PubkeyConverter(AddressBytesLen, core.DefaultAddressPrefix)
+
+// NewAddressPublicKeyConverter creates a new address public key converter using the provided human-readable part
+// (the bech32 prefix), for the chains that do not use the default erd prefix
+func NewAddressPublicKeyConverter(hrp string) (core.PubkeyConverter, error) {
+	return pubkeyConverter.NewBech32PubkeyConverter(AddressBytesLen, hrp)
+}
+
+// AddressConverterOrDefault returns the provided address converter or, if nil, the default AddressPublicKeyConverter
+func AddressConverterOrDefault(converter AddressConverter) AddressConverter {
+	if check.IfNil(converter) {
+		return AddressPublicKeyConverter
+	}
+
+	return converter
+}
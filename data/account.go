@@ -55,6 +55,11 @@ func (a *Account) GetBalance(decimals int) (float64, error) {
 	return floatBalance, nil
 }
 
+// GetBalanceAmount returns the exact EGLD balance of the account
+func (a *Account) GetBalanceAmount() (*Amount, error) {
+	return NewAmountFromTransactionValue(a.Balance)
+}
+
 // ESDTFungibleResponse holds the ESDT (fungible) token data endpoint response
 type ESDTFungibleResponse struct {
 	Data struct {
@@ -71,6 +76,11 @@ type ESDTFungibleTokenData struct {
 	Properties      string `json:"properties"`
 }
 
+// GetAmount returns the exact token balance, provided the token's number of decimals
+func (tokenData *ESDTFungibleTokenData) GetAmount(decimals uint32) (*Amount, error) {
+	return newAmountFromDenominatedString(tokenData.Balance, decimals, tokenData.TokenIdentifier)
+}
+
 // ESDTNFTResponse holds the NFT token data endpoint response
 type ESDTNFTResponse struct {
 	Data struct {
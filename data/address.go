@@ -9,13 +9,21 @@ import (
 const offsetPretty = 8
 
 type address struct {
-	bytes []byte
+	bytes     []byte
+	converter core.AddressConverter
 }
 
 // NewAddressFromBytes returns a new address from provided bytes
 func NewAddressFromBytes(bytes []byte) *address {
+	return NewAddressFromBytesWithConverter(bytes, core.AddressPublicKeyConverter)
+}
+
+// NewAddressFromBytesWithConverter returns a new address from provided bytes, that uses the provided address converter
+// for its bech32 representation. A nil converter means the default one
+func NewAddressFromBytesWithConverter(bytes []byte, converter core.AddressConverter) *address {
 	addr := &address{
-		bytes: make([]byte, len(bytes)),
+		bytes:     make([]byte, len(bytes)),
+		converter: core.AddressConverterOrDefault(converter),
 	}
 	copy(addr.bytes, bytes)
 
@@ -24,19 +32,27 @@ func NewAddressFromBytes(bytes []byte) *address {
 
 // NewAddressFromBech32String returns a new address from provided bech32 string
 func NewAddressFromBech32String(bech32 string) (*address, error) {
-	buff, err := core.AddressPublicKeyConverter.Decode(bech32)
+	return NewAddressFromBech32StringWithConverter(bech32, core.AddressPublicKeyConverter)
+}
+
+// NewAddressFromBech32StringWithConverter returns a new address from provided bech32 string, decoded with the provided
+// address converter. A nil converter means the default one
+func NewAddressFromBech32StringWithConverter(bech32 string, converter core.AddressConverter) (*address, error) {
+	converter = core.AddressConverterOrDefault(converter)
+	buff, err := converter.Decode(bech32)
 	if err != nil {
 		return nil, err
 	}
 
 	return &address{
-		bytes: buff,
+		bytes:     buff,
+		converter: converter,
 	}, err
 }
 
 // AddressAsBech32String returns the address as a bech32 string
 func (a *address) AddressAsBech32String() (string, error) {
-	return core.AddressPublicKeyConverter.Encode(a.bytes)
+	return core.AddressConverterOrDefault(a.converter).Encode(a.bytes)
 }
 
 // AddressBytes returns the raw address' bytes
@@ -0,0 +1,244 @@
+package data
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+const (
+	// EGLDTicker is the ticker of the native token
+	EGLDTicker = "EGLD"
+	// EGLDDecimals is the number of decimals of the native token
+	EGLDDecimals = 18
+
+	decimalSeparator = "."
+)
+
+var errMalformedNumber = errors.New("malformed number")
+
+// Amount is an exact token amount, kept as the denominated integer value (the one used on chain) together with the
+// token's number of decimals and its ticker (EGLD or the ESDT token identifier). Amounts are immutable, all the
+// arithmetic operations return new instances
+type Amount struct {
+	value    *big.Int
+	decimals uint32
+	ticker   string
+}
+
+// NewAmount creates a new amount from the denominated value, as found on chain
+func NewAmount(value *big.Int, decimals uint32, ticker string) *Amount {
+	amount := &Amount{
+		value:    big.NewInt(0),
+		decimals: decimals,
+		ticker:   ticker,
+	}
+	if value != nil {
+		amount.value.Set(value)
+	}
+
+	return amount
+}
+
+// NewEGLDAmount creates a new EGLD amount from the denominated value
+func NewEGLDAmount(value *big.Int) *Amount {
+	return NewAmount(value, EGLDDecimals, EGLDTicker)
+}
+
+// NewAmountFromTransactionValue creates a new EGLD amount from the value field of a transaction
+func NewAmountFromTransactionValue(value string) (*Amount, error) {
+	return newAmountFromDenominatedString(value, EGLDDecimals, EGLDTicker)
+}
+
+// ParseAmount parses a human-readable amount such as "12.000001 USDC-c76f1f" or "0.5". The ticker is optional and
+// the number of decimals should be the token's one. Amounts with more decimals than the token has are rejected
+func ParseAmount(amount string, decimals uint32) (*Amount, error) {
+	fields := strings.Fields(amount)
+	if len(fields) == 0 || len(fields) > 2 {
+		return nil, fmt.Errorf("%w: %q", ErrInvalidAmount, amount)
+	}
+
+	ticker := ""
+	if len(fields) == 2 {
+		ticker = fields[1]
+	}
+
+	value, err := parseDecimalString(fields[0], decimals)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %q, %s", ErrInvalidAmount, amount, err.Error())
+	}
+
+	return &Amount{
+		value:    value,
+		decimals: decimals,
+		ticker:   ticker,
+	}, nil
+}
+
+// ParseEGLDAmount parses a human-readable EGLD amount such as "1.5 EGLD" or "1.5"
+func ParseEGLDAmount(amount string) (*Amount, error) {
+	parsed, err := ParseAmount(amount, EGLDDecimals)
+	if err != nil {
+		return nil, err
+	}
+	if len(parsed.ticker) > 0 && parsed.ticker != EGLDTicker {
+		return nil, fmt.Errorf("%w: %q is not an %s amount", ErrInvalidAmount, amount, EGLDTicker)
+	}
+	parsed.ticker = EGLDTicker
+
+	return parsed, nil
+}
+
+func newAmountFromDenominatedString(value string, decimals uint32, ticker string) (*Amount, error) {
+	bigValue, ok := big.NewInt(0).SetString(value, 10)
+	if !ok {
+		return nil, fmt.Errorf("%w: %q is not a denominated value", ErrInvalidAmount, value)
+	}
+
+	return &Amount{
+		value:    bigValue,
+		decimals: decimals,
+		ticker:   ticker,
+	}, nil
+}
+
+func parseDecimalString(number string, decimals uint32) (*big.Int, error) {
+	integerPart, fractionalPart, hasSeparator := strings.Cut(number, decimalSeparator)
+	if len(integerPart) == 0 || (hasSeparator && len(fractionalPart) == 0) {
+		return nil, errMalformedNumber
+	}
+	if !isDigitsOnly(integerPart) || !isDigitsOnly(fractionalPart) {
+		return nil, errMalformedNumber
+	}
+
+	fractionalPart = strings.TrimRight(fractionalPart, "0")
+	if uint32(len(fractionalPart)) > decimals {
+		return nil, fmt.Errorf("more than %d decimals", decimals)
+	}
+
+	fractionalPart += strings.Repeat("0", int(decimals)-len(fractionalPart))
+	value, _ := big.NewInt(0).SetString(integerPart+fractionalPart, 10)
+
+	return value, nil
+}
+
+func isDigitsOnly(str string) bool {
+	for _, c := range str {
+		if c < '0' || c > '9' {
+			return false
+		}
+	}
+
+	return true
+}
+
+// Value returns a copy of the denominated value
+func (amount *Amount) Value() *big.Int {
+	return big.NewInt(0).Set(amount.value)
+}
+
+// Decimals returns the number of decimals of the token
+func (amount *Amount) Decimals() uint32 {
+	return amount.decimals
+}
+
+// Ticker returns the ticker of the token (EGLD or the ESDT token identifier)
+func (amount *Amount) Ticker() string {
+	return amount.ticker
+}
+
+// Sign returns -1, 0 or +1 depending on the amount's sign
+func (amount *Amount) Sign() int {
+	return amount.value.Sign()
+}
+
+// IsZero returns true if the amount is 0
+func (amount *Amount) IsZero() bool {
+	return amount.value.Sign() == 0
+}
+
+// Add returns the sum of the two amounts. The amounts should be of the same token
+func (amount *Amount) Add(other *Amount) (*Amount, error) {
+	err := amount.checkCompatible(other)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAmount(big.NewInt(0).Add(amount.value, other.value), amount.decimals, amount.ticker), nil
+}
+
+// Sub returns the difference of the two amounts. The amounts should be of the same token
+func (amount *Amount) Sub(other *Amount) (*Amount, error) {
+	err := amount.checkCompatible(other)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewAmount(big.NewInt(0).Sub(amount.value, other.value), amount.decimals, amount.ticker), nil
+}
+
+// Cmp compares the two amounts, returning -1, 0 or +1. The amounts should be of the same token
+func (amount *Amount) Cmp(other *Amount) (int, error) {
+	err := amount.checkCompatible(other)
+	if err != nil {
+		return 0, err
+	}
+
+	return amount.value.Cmp(other.value), nil
+}
+
+func (amount *Amount) checkCompatible(other *Amount) error {
+	if other == nil {
+		return fmt.Errorf("%w: nil amount", ErrIncompatibleAmounts)
+	}
+	if amount.decimals != other.decimals || amount.ticker != other.ticker {
+		return fmt.Errorf("%w: %s with %d decimals and %s with %d decimals",
+			ErrIncompatibleAmounts, amount.ticker, amount.decimals, other.ticker, other.decimals)
+	}
+
+	return nil
+}
+
+// FormatValue returns the human-readable value, without the ticker and without the trailing zero decimals
+func (amount *Amount) FormatValue() string {
+	absValue := big.NewInt(0).Abs(amount.value)
+	denomination := big.NewInt(0).Exp(big.NewInt(10), big.NewInt(int64(amount.decimals)), nil)
+	integerPart, fractionalPart := big.NewInt(0).QuoRem(absValue, denomination, big.NewInt(0))
+
+	sign := ""
+	if amount.value.Sign() < 0 {
+		sign = "-"
+	}
+	if fractionalPart.Sign() == 0 {
+		return sign + integerPart.String()
+	}
+
+	fractional := fractionalPart.String()
+	fractional = strings.Repeat("0", int(amount.decimals)-len(fractional)) + fractional
+	fractional = strings.TrimRight(fractional, "0")
+
+	return sign + integerPart.String() + decimalSeparator + fractional
+}
+
+// String returns the human-readable amount, followed by the ticker (if set), such as "1.5 EGLD"
+func (amount *Amount) String() string {
+	if len(amount.ticker) == 0 {
+		return amount.FormatValue()
+	}
+
+	return amount.FormatValue() + " " + amount.ticker
+}
+
+// ToTransactionValue returns the denominated value as expected by the value field of a transaction
+func (amount *Amount) ToTransactionValue() (string, error) {
+	if amount.ticker != EGLDTicker || amount.decimals != EGLDDecimals {
+		return "", fmt.Errorf("%w: only %s amounts can be set as transaction value, got %s",
+			ErrIncompatibleAmounts, EGLDTicker, amount.ticker)
+	}
+	if amount.value.Sign() < 0 {
+		return "", fmt.Errorf("%w: negative transaction value %s", ErrInvalidAmount, amount.String())
+	}
+
+	return amount.value.String(), nil
+}
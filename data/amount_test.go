@@ -0,0 +1,156 @@
+package data
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestParseAmount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid amounts should error", func(t *testing.T) {
+		t.Parallel()
+
+		invalidAmounts := []string{
+			"",
+			"  ",
+			"1.5 EGLD extra",
+			"abc",
+			"1,5",
+			"-1",
+			".5",
+			"1.",
+			"1.2.3",
+			"0x10",
+			"1.0000001 USDC-c76f1f",
+		}
+		for _, amount := range invalidAmounts {
+			parsed, err := ParseAmount(amount, 6)
+			assert.Nil(t, parsed, amount)
+			assert.ErrorIs(t, err, ErrInvalidAmount, amount)
+		}
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		amount, err := ParseAmount("12.000001 USDC-c76f1f", 6)
+		require.Nil(t, err)
+		assert.Equal(t, big.NewInt(12000001), amount.Value())
+		assert.Equal(t, uint32(6), amount.Decimals())
+		assert.Equal(t, "USDC-c76f1f", amount.Ticker())
+		assert.Equal(t, "12.000001 USDC-c76f1f", amount.String())
+
+		amount, err = ParseAmount("7.100000", 6)
+		require.Nil(t, err)
+		assert.Equal(t, big.NewInt(7100000), amount.Value())
+		assert.Empty(t, amount.Ticker())
+		assert.Equal(t, "7.1", amount.String())
+
+		amount, err = ParseAmount("42", 0)
+		require.Nil(t, err)
+		assert.Equal(t, big.NewInt(42), amount.Value())
+	})
+}
+
+func TestParseEGLDAmount(t *testing.T) {
+	t.Parallel()
+
+	amount, err := ParseEGLDAmount("1.5 EGLD")
+	require.Nil(t, err)
+	assert.Equal(t, "1500000000000000000", amount.Value().String())
+	assert.Equal(t, "1.5 EGLD", amount.String())
+
+	amount, err = ParseEGLDAmount("0.000000000000000001")
+	require.Nil(t, err)
+	assert.Equal(t, big.NewInt(1), amount.Value())
+	assert.Equal(t, "0.000000000000000001 EGLD", amount.String())
+
+	amount, err = ParseEGLDAmount("1.5 USDC-c76f1f")
+	assert.Nil(t, amount)
+	assert.ErrorIs(t, err, ErrInvalidAmount)
+
+	amount, err = ParseEGLDAmount("0.0000000000000000001")
+	assert.Nil(t, amount)
+	assert.ErrorIs(t, err, ErrInvalidAmount)
+}
+
+func TestAmount_TransactionValueConversions(t *testing.T) {
+	t.Parallel()
+
+	amount, err := NewAmountFromTransactionValue("1000000000000000000")
+	require.Nil(t, err)
+	assert.Equal(t, "1 EGLD", amount.String())
+
+	value, err := amount.ToTransactionValue()
+	assert.Nil(t, err)
+	assert.Equal(t, "1000000000000000000", value)
+
+	amount, err = NewAmountFromTransactionValue("1.5")
+	assert.Nil(t, amount)
+	assert.ErrorIs(t, err, ErrInvalidAmount)
+
+	value, err = NewAmount(big.NewInt(1), 6, "USDC-c76f1f").ToTransactionValue()
+	assert.Empty(t, value)
+	assert.ErrorIs(t, err, ErrIncompatibleAmounts)
+
+	value, err = NewEGLDAmount(big.NewInt(-1)).ToTransactionValue()
+	assert.Empty(t, value)
+	assert.ErrorIs(t, err, ErrInvalidAmount)
+}
+
+func TestAmount_BalanceConversions(t *testing.T) {
+	t.Parallel()
+
+	account := &Account{Balance: "2500000000000000000"}
+	amount, err := account.GetBalanceAmount()
+	require.Nil(t, err)
+	assert.Equal(t, "2.5 EGLD", amount.String())
+
+	tokenData := &ESDTFungibleTokenData{
+		TokenIdentifier: "USDC-c76f1f",
+		Balance:         "12000001",
+	}
+	amount, err = tokenData.GetAmount(6)
+	require.Nil(t, err)
+	assert.Equal(t, "12.000001 USDC-c76f1f", amount.String())
+}
+
+func TestAmount_Arithmetic(t *testing.T) {
+	t.Parallel()
+
+	first, _ := ParseEGLDAmount("1.1")
+	second, _ := ParseEGLDAmount("0.2")
+
+	sum, err := first.Add(second)
+	require.Nil(t, err)
+	assert.Equal(t, "1.3 EGLD", sum.String())
+
+	difference, err := second.Sub(first)
+	require.Nil(t, err)
+	assert.Equal(t, "-0.9 EGLD", difference.String())
+	assert.Equal(t, -1, difference.Sign())
+
+	result, err := first.Cmp(second)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, result)
+
+	zero, _ := first.Sub(first)
+	assert.True(t, zero.IsZero())
+	assert.Equal(t, "0 EGLD", zero.String())
+
+	// the operands are not changed
+	assert.Equal(t, "1.1 EGLD", first.String())
+
+	token := NewAmount(big.NewInt(1), 18, "WEGLD-bd4d79")
+	_, err = first.Add(token)
+	assert.ErrorIs(t, err, ErrIncompatibleAmounts)
+	_, err = first.Cmp(nil)
+	assert.ErrorIs(t, err, ErrIncompatibleAmounts)
+
+	// the returned value can not alter the amount
+	first.Value().SetInt64(0)
+	assert.Equal(t, "1.1 EGLD", first.String())
+}
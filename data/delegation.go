@@ -0,0 +1,29 @@
+package data
+
+import "math/big"
+
+// DelegationContractConfig holds the configuration of a delegation contract, as returned by the getContractConfig query
+type DelegationContractConfig struct {
+	OwnerAddress         string
+	ServiceFee           uint64
+	MaxDelegationCap     *big.Int
+	InitialOwnerFunds    *big.Int
+	AutomaticActivation  bool
+	WithDelegationCap    bool
+	ChangeableServiceFee bool
+	CheckCapOnReDelegate bool
+	CreatedNonce         uint64
+	UnBondPeriod         uint64
+}
+
+// UnDelegatedFund holds an un-delegated amount and the number of epochs left until it can be withdrawn
+type UnDelegatedFund struct {
+	Amount          *big.Int
+	RemainingEpochs uint64
+}
+
+// NodeState holds a validator's BLS public key and its state in the staking system (staked, notStaked, unStaked, jailed...)
+type NodeState struct {
+	BLSKey []byte
+	State  string
+}
@@ -0,0 +1,18 @@
+package data
+
+import "errors"
+
+// ErrInvalidAmount signals that an invalid amount was provided
+var ErrInvalidAmount = errors.New("invalid amount")
+
+// ErrIncompatibleAmounts signals that the amounts are not of the same token
+var ErrIncompatibleAmounts = errors.New("incompatible amounts")
+
+// ErrInvalidRoyalties signals that the NFT royalties are not a valid unsigned 32 bits number
+var ErrInvalidRoyalties = errors.New("invalid royalties")
+
+// ErrNilESDTNFTTokenData signals that a nil ESDT NFT token data was provided
+var ErrNilESDTNFTTokenData = errors.New("nil ESDT NFT token data")
+
+// ErrInsufficientGasLimit signals that the gas limit does not cover the move balance cost of the transaction
+var ErrInsufficientGasLimit = errors.New("insufficient gas limit")
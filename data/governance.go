@@ -0,0 +1,36 @@
+package data
+
+import "math/big"
+
+// GovernanceConfig holds the governance system smart contract configuration, as returned by the viewConfig query
+type GovernanceConfig struct {
+	ProposalFee       *big.Int
+	LostProposalFee   *big.Int
+	MinQuorum         float64
+	MinPassThreshold  float64
+	MinVetoThreshold  float64
+	LastProposalNonce uint64
+}
+
+// GovernanceProposal holds a governance proposal, as returned by the viewProposal query
+type GovernanceProposal struct {
+	Cost           *big.Int
+	CommitHash     string
+	Nonce          uint64
+	IssuerAddress  string
+	StartVoteEpoch uint64
+	EndVoteEpoch   uint64
+	QuorumStake    *big.Int
+	Yes            *big.Int
+	No             *big.Int
+	Veto           *big.Int
+	Abstain        *big.Int
+	Closed         bool
+	Passed         bool
+}
+
+// UserVoteHistory holds the nonces of the proposals a user voted, directly or through a delegation contract
+type UserVoteHistory struct {
+	DirectVotes    []uint64
+	DelegatedVotes []uint64
+}
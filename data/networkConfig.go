@@ -1,5 +1,7 @@
 package data
 
+import "github.com/multiversx/mx-chain-core-go/data/transaction"
+
 // NetworkConfigResponse holds the network config endpoint response
 type NetworkConfigResponse struct {
 	Data struct {
@@ -29,4 +31,19 @@ type NetworkConfig struct {
 	Hysteresys               float32 `json:"erd_hysteresis,string"`
 	RoundsPerEpoch           uint32  `json:"erd_rounds_per_epoch"`
 	ExtraGasLimitGuardedTx   uint64  `json:"erd_extra_gas_limit_guarded_tx"`
+	GasPriceModifier         float64 `json:"erd_gas_price_modifier,string"`
+}
+
+// ComputeMoveBalanceGasLimit computes, offline, the gas limit needed for the transaction's move balance part: the
+// minimum gas limit, the data field cost and the extra gas of the guarded and of the relayed (v3) transactions
+func (config *NetworkConfig) ComputeMoveBalanceGasLimit(tx *transaction.FrontendTransaction) uint64 {
+	gasLimit := config.MinGasLimit + config.GasPerDataByte*uint64(len(tx.Data))
+	if tx.Options&transaction.MaskGuardedTransaction != 0 {
+		gasLimit += config.ExtraGasLimitGuardedTx
+	}
+	if len(tx.RelayerAddr) > 0 {
+		gasLimit += config.MinGasLimit
+	}
+
+	return gasLimit
 }
@@ -0,0 +1,126 @@
+package data
+
+import (
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+const (
+	royaltiesDenominator      = 100
+	attributesFieldsSeparator = ";"
+	attributesKeyValueSep     = ":"
+	attributesTagsSeparator   = ","
+	attributesMetadataKey     = "metadata"
+	attributesTagsKey         = "tags"
+)
+
+// NFT holds the decoded view of an NFT, SFT or meta ESDT token data
+type NFT struct {
+	TokenIdentifier     string
+	Nonce               uint64
+	Name                string
+	Creator             string
+	Balance             *big.Int
+	Royalties           uint32
+	RoyaltiesPercentage float64
+	Hash                []byte
+	URIs                []string
+	Attributes          *NFTAttributes
+}
+
+// NFTAttributes holds the raw NFT attributes and their fields, as parsed using the
+// common `metadata:...;tags:...` convention
+type NFTAttributes struct {
+	Raw      []byte
+	Metadata string
+	Tags     []string
+	Fields   map[string]string
+}
+
+// ToNFT decodes the token data into the typed NFT view
+func (tokenData *ESDTNFTTokenData) ToNFT() (*NFT, error) {
+	if tokenData == nil {
+		return nil, ErrNilESDTNFTTokenData
+	}
+
+	balance := big.NewInt(0)
+	if len(tokenData.Balance) > 0 {
+		_, ok := balance.SetString(tokenData.Balance, 10)
+		if !ok {
+			return nil, errInvalidBalance
+		}
+	}
+
+	var royalties uint64
+	if len(tokenData.Royalties) > 0 {
+		var err error
+		royalties, err = strconv.ParseUint(tokenData.Royalties, 10, 32)
+		if err != nil {
+			return nil, ErrInvalidRoyalties
+		}
+	}
+
+	uris := make([]string, 0, len(tokenData.URIs))
+	for _, uri := range tokenData.URIs {
+		uris = append(uris, string(uri))
+	}
+
+	return &NFT{
+		TokenIdentifier:     tokenData.TokenIdentifier,
+		Nonce:               tokenData.Nonce,
+		Name:                tokenData.Name,
+		Creator:             tokenData.Creator,
+		Balance:             balance,
+		Royalties:           uint32(royalties),
+		RoyaltiesPercentage: float64(royalties) / royaltiesDenominator,
+		Hash:                tokenData.Hash,
+		URIs:                uris,
+		Attributes:          ParseNFTAttributes(tokenData.Attributes),
+	}, nil
+}
+
+// ParseNFTAttributes splits the raw attributes into key-value fields. Fields are separated by `;`
+// and each field is a `key:value` pair. The `tags` field is further split by `,`.
+// Attributes not following this convention are only available as raw bytes
+func ParseNFTAttributes(raw []byte) *NFTAttributes {
+	attributes := &NFTAttributes{
+		Raw:    raw,
+		Tags:   make([]string, 0),
+		Fields: make(map[string]string),
+	}
+
+	for _, field := range strings.Split(string(raw), attributesFieldsSeparator) {
+		keyValue := strings.SplitN(field, attributesKeyValueSep, 2)
+		if len(keyValue) != 2 {
+			continue
+		}
+
+		key := strings.TrimSpace(keyValue[0])
+		value := strings.TrimSpace(keyValue[1])
+		attributes.Fields[key] = value
+
+		switch key {
+		case attributesMetadataKey:
+			attributes.Metadata = value
+		case attributesTagsKey:
+			attributes.Tags = splitTags(value)
+		}
+	}
+
+	return attributes
+}
+
+func splitTags(value string) []string {
+	tags := make([]string, 0)
+	for _, tag := range strings.Split(value, attributesTagsSeparator) {
+		tag = strings.TrimSpace(tag)
+		if len(tag) == 0 {
+			continue
+		}
+
+		tags = append(tags, tag)
+	}
+
+	return tags
+}
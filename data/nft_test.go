@@ -0,0 +1,95 @@
+package data
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestESDTNFTTokenData_ToNFT(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil token data should error", func(t *testing.T) {
+		t.Parallel()
+
+		var tokenData *ESDTNFTTokenData
+		nft, err := tokenData.ToNFT()
+		assert.Nil(t, nft)
+		assert.Equal(t, ErrNilESDTNFTTokenData, err)
+	})
+	t.Run("invalid balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		tokenData := &ESDTNFTTokenData{
+			Balance: "not a number",
+		}
+		nft, err := tokenData.ToNFT()
+		assert.Nil(t, nft)
+		assert.Equal(t, errInvalidBalance, err)
+	})
+	t.Run("invalid royalties should error", func(t *testing.T) {
+		t.Parallel()
+
+		tokenData := &ESDTNFTTokenData{
+			Balance:   "1",
+			Royalties: "-1",
+		}
+		nft, err := tokenData.ToNFT()
+		assert.Nil(t, nft)
+		assert.Equal(t, ErrInvalidRoyalties, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		tokenData := &ESDTNFTTokenData{
+			TokenIdentifier: "NFT-123456-0a",
+			Balance:         "1",
+			Name:            "Token #10",
+			Nonce:           10,
+			Creator:         "erd1qqqqqqqqqqqqqpgqfzydqmdw7m2vazsp6u5p95yxz76t2p9rd8ss0zp9ts",
+			Royalties:       "750",
+			Hash:            []byte("hash"),
+			URIs:            [][]byte{[]byte("https://uri1"), []byte("https://uri2")},
+			Attributes:      []byte("metadata:QmCid/10.json;tags:art, music,,rare"),
+		}
+		nft, err := tokenData.ToNFT()
+		require.Nil(t, err)
+
+		assert.Equal(t, "NFT-123456-0a", nft.TokenIdentifier)
+		assert.Equal(t, uint64(10), nft.Nonce)
+		assert.Equal(t, "Token #10", nft.Name)
+		assert.Equal(t, tokenData.Creator, nft.Creator)
+		assert.Equal(t, big.NewInt(1), nft.Balance)
+		assert.Equal(t, uint32(750), nft.Royalties)
+		assert.Equal(t, 7.5, nft.RoyaltiesPercentage)
+		assert.Equal(t, []byte("hash"), nft.Hash)
+		assert.Equal(t, []string{"https://uri1", "https://uri2"}, nft.URIs)
+		assert.Equal(t, tokenData.Attributes, nft.Attributes.Raw)
+		assert.Equal(t, "QmCid/10.json", nft.Attributes.Metadata)
+		assert.Equal(t, []string{"art", "music", "rare"}, nft.Attributes.Tags)
+	})
+}
+
+func TestParseNFTAttributes(t *testing.T) {
+	t.Parallel()
+
+	t.Run("attributes not following the convention", func(t *testing.T) {
+		t.Parallel()
+
+		raw := []byte{0x01, 0x02, 0x03}
+		attributes := ParseNFTAttributes(raw)
+		assert.Equal(t, raw, attributes.Raw)
+		assert.Empty(t, attributes.Metadata)
+		assert.Empty(t, attributes.Tags)
+		assert.Empty(t, attributes.Fields)
+	})
+	t.Run("custom fields", func(t *testing.T) {
+		t.Parallel()
+
+		attributes := ParseNFTAttributes([]byte("tags:a;level:5;url:https://x.io"))
+		assert.Equal(t, []string{"a"}, attributes.Tags)
+		assert.Equal(t, map[string]string{"tags": "a", "level": "5", "url": "https://x.io"}, attributes.Fields)
+	})
+}
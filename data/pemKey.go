@@ -0,0 +1,11 @@
+package data
+
+import "github.com/multiversx/mx-sdk-go/core"
+
+// PemKey is a private key loaded from a .pem file, together with its label (usually the bech32 address) and the
+// address computed from the private key
+type PemKey struct {
+	Label      string
+	PrivateKey []byte
+	Address    core.AddressHandler
+}
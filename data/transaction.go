@@ -1,6 +1,10 @@
 package data
 
-import "github.com/multiversx/mx-chain-core-go/data/transaction"
+import (
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
 
 // SendTransactionResponse holds the response received from the network when broadcasting a transaction
 type SendTransactionResponse struct {
@@ -92,3 +96,16 @@ type ResponseTxCost struct {
 	Error string             `json:"error"`
 	Code  string             `json:"code"`
 }
+
+// TransactionFeeBreakdown holds the gas units and the fee of a transaction, split between the move balance part
+// (charged at the full gas price) and the processing part (charged at the gas price adjusted by the gas price modifier)
+type TransactionFeeBreakdown struct {
+	GasLimit           uint64   `json:"gasLimit"`
+	GasPrice           uint64   `json:"gasPrice"`
+	MoveBalanceGas     uint64   `json:"moveBalanceGas"`
+	ProcessingGas      uint64   `json:"processingGas"`
+	ProcessingGasPrice uint64   `json:"processingGasPrice"`
+	MoveBalanceFee     *big.Int `json:"moveBalanceFee"`
+	ProcessingFee      *big.Int `json:"processingFee"`
+	Fee                *big.Int `json:"fee"`
+}
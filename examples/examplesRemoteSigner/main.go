@@ -0,0 +1,77 @@
+package main
+
+import (
+	"math/big"
+	"net/http"
+
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	logger "github.com/multiversx/mx-chain-logger-go"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/examples"
+	"github.com/multiversx/mx-sdk-go/interactors"
+	"github.com/multiversx/mx-sdk-go/remoteSigner"
+)
+
+const listenAddress = "127.0.0.1:8090"
+
+var (
+	suite  = ed25519.NewEd25519()
+	keyGen = signing.NewKeyGenerator(suite)
+	log    = logger.GetOrCreate("mx-sdk-go/examples/examplesRemoteSigner")
+)
+
+// main starts a local remote signer holding alice's key. It only signs testnet transactions sent to bob, carrying at
+// most 1 EGLD. The clients use remoteSigner.NewRemoteSignerClient with alice's address as their transaction signer
+func main() {
+	_ = logger.SetLogLevel("*:DEBUG")
+
+	w := interactors.NewWallet()
+	privateKey, err := w.LoadPrivateKeyFromPemData([]byte(examples.AlicePemContents))
+	if err != nil {
+		log.Error("unable to load alice.pem", "error", err)
+		return
+	}
+
+	holder, err := cryptoProvider.NewCryptoComponentsHolder(keyGen, privateKey)
+	if err != nil {
+		log.Error("unable to create the crypto components holder", "error", err)
+		return
+	}
+
+	signer := cryptoProvider.NewSigner()
+	txSigner, err := builders.NewCryptoHolderTransactionSigner(signer, holder)
+	if err != nil {
+		log.Error("unable to create the transaction signer", "error", err)
+		return
+	}
+
+	txBuilder, err := builders.NewTxBuilder(signer)
+	if err != nil {
+		log.Error("unable to create the transaction builder", "error", err)
+		return
+	}
+
+	oneEGLD, _ := big.NewInt(0).SetString("1000000000000000000", 10)
+	server, err := remoteSigner.NewRemoteSignerServer(remoteSigner.ArgsRemoteSignerServer{
+		TxSigner:     txSigner,
+		DataComputer: txBuilder,
+		Policy: remoteSigner.SigningPolicy{
+			AllowedChainIDs:  []string{"T"},
+			AllowedReceivers: []string{"erd1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqzu66jx"},
+			MaxValue:         oneEGLD,
+			AllowMessages:    true,
+		},
+	})
+	if err != nil {
+		log.Error("unable to create the remote signer server", "error", err)
+		return
+	}
+
+	log.Info("remote signer started", "address", holder.GetBech32(), "listen", listenAddress)
+	err = http.ListenAndServe(listenAddress, server)
+	if err != nil {
+		log.Error("remote signer stopped", "error", err)
+	}
+}
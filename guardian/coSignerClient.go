@@ -0,0 +1,182 @@
+package guardian
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"reflect"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+// ArgsCoSignerClient is the DTO used in the co-signer client constructor
+type ArgsCoSignerClient struct {
+	HttpClientWrapper HttpClientWrapper
+	// CodeProvider is optional, it is only required when co-signing transactions through CoSignTransaction
+	CodeProvider CodeProvider
+}
+
+type coSignerClient struct {
+	httpClientWrapper HttpClientWrapper
+	codeProvider      CodeProvider
+	getTimeHandler    func() time.Time
+}
+
+// NewCoSignerClient creates a new client for a trusted co-signer service
+func NewCoSignerClient(args ArgsCoSignerClient) (*coSignerClient, error) {
+	if check.IfNil(args.HttpClientWrapper) {
+		return nil, ErrNilHttpClientWrapper
+	}
+
+	return &coSignerClient{
+		httpClientWrapper: args.HttpClientWrapper,
+		codeProvider:      args.CodeProvider,
+		getTimeHandler:    time.Now,
+	}, nil
+}
+
+// RegisterGuardian registers a new guardian for the user, proving the ownership of the address by signing the
+// registration message with the user's signer. The code is only required when replacing a verified registration and
+// it has to be a valid code of the current secret. The returned OTP info should be imported in an authenticator
+// application and the registration confirmed with VerifyCode. The returned guardian address should then be set on
+// the account through a SetGuardian transaction
+func (client *coSignerClient) RegisterGuardian(
+	ctx context.Context,
+	userSigner core.TransactionSigner,
+	tag string,
+	code string,
+) (*RegisterGuardianResponse, error) {
+	if check.IfNil(userSigner) {
+		return nil, ErrNilTransactionSigner
+	}
+
+	userAddress := userSigner.GetBech32()
+	timestamp := client.getTimeHandler().Unix()
+	signature, err := userSigner.SignMessage(ComputeRegistrationMessage(userAddress, tag, timestamp))
+	if err != nil {
+		return nil, err
+	}
+
+	request := &RegisterGuardianRequest{
+		UserAddress: userAddress,
+		Tag:         tag,
+		Timestamp:   timestamp,
+		Signature:   hex.EncodeToString(signature),
+		Code:        code,
+	}
+	response := &RegisterGuardianResponse{}
+	err = client.post(ctx, registerEndpoint, request, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response, nil
+}
+
+// VerifyCode confirms the guardian registration with a code from the authenticator application
+func (client *coSignerClient) VerifyCode(ctx context.Context, userAddress string, guardian string, code string) error {
+	request := &VerifyCodeRequest{
+		UserAddress: userAddress,
+		Guardian:    guardian,
+		Code:        code,
+	}
+
+	return client.post(ctx, verifyCodeEndpoint, request, nil)
+}
+
+// SignTransaction sends the user-signed transaction together with the 2FA code and returns the co-signed transaction
+func (client *coSignerClient) SignTransaction(
+	ctx context.Context,
+	tx *transaction.FrontendTransaction,
+	code string,
+) (*transaction.FrontendTransaction, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+
+	request := &SignTransactionRequest{
+		Code:        code,
+		Transaction: tx,
+	}
+	response := &SignTransactionResponse{}
+	err := client.post(ctx, signTransactionEndpoint, request, response)
+	if err != nil {
+		return nil, err
+	}
+	if response.Transaction == nil {
+		return nil, ErrNilTransaction
+	}
+
+	return response.Transaction, nil
+}
+
+// CoSignTransaction applies the guardian signature returned by the co-signer service on the provided transaction,
+// using the code from the code provider. The co-signed transaction should only differ by the guardian signature
+func (client *coSignerClient) CoSignTransaction(ctx context.Context, tx *transaction.FrontendTransaction) error {
+	if check.IfNil(client.codeProvider) {
+		return ErrNilCodeProvider
+	}
+
+	code, err := client.codeProvider.GetCode()
+	if err != nil {
+		return err
+	}
+
+	coSignedTx, err := client.SignTransaction(ctx, tx, code)
+	if err != nil {
+		return err
+	}
+
+	expectedTx := *coSignedTx
+	expectedTx.GuardianSignature = tx.GuardianSignature
+	if !reflect.DeepEqual(&expectedTx, tx) {
+		return ErrCoSignedTransactionMismatch
+	}
+
+	tx.GuardianSignature = coSignedTx.GuardianSignature
+
+	return nil
+}
+
+func (client *coSignerClient) post(ctx context.Context, endpoint string, request interface{}, responseData interface{}) error {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return err
+	}
+
+	buff, code, err := client.httpClientWrapper.PostHTTP(ctx, endpoint, requestBytes)
+	if err != nil {
+		return err
+	}
+
+	response := &coSignerResponse{}
+	errUnmarshal := json.Unmarshal(buff, response)
+	if code != http.StatusOK {
+		if errUnmarshal == nil && len(response.Error) > 0 {
+			return createHTTPStatusError(code, errors.New(response.Error))
+		}
+
+		return createHTTPStatusError(code, errUnmarshal)
+	}
+	if errUnmarshal != nil {
+		return errUnmarshal
+	}
+	if len(response.Error) > 0 {
+		return errors.New(response.Error)
+	}
+	if responseData == nil {
+		return nil
+	}
+
+	return json.Unmarshal(response.Data, responseData)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (client *coSignerClient) IsInterfaceNil() bool {
+	return client == nil
+}
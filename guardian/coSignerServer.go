@@ -0,0 +1,384 @@
+package guardian
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	defaultIssuer = "MultiversX"
+	// the maximum difference between the registration timestamp and the server's time
+	registrationTimeWindow = 5 * time.Minute
+)
+
+// ArgsCoSignerServer is the DTO used in the reference co-signer server constructor
+type ArgsCoSignerServer struct {
+	GuardianCryptoHolder core.CryptoComponentsHolder
+	TxSigner             GuardianTxSigner
+	// SignatureVerifier and KeyGenerator are used to check the users' registration and transaction signatures
+	SignatureVerifier SignatureVerifier
+	KeyGenerator      crypto.KeyGenerator
+	Issuer            string
+	// AddressConverter is optional, when not provided the default erd prefixed address converter is used
+	AddressConverter core.AddressConverter
+}
+
+type registeredUser struct {
+	totp                  *totp
+	verified              bool
+	registrationTimestamp int64
+}
+
+// coSignerServer is an in-process reference implementation of a trusted co-signer service. It holds a single guardian
+// key and protects its usage with per-user RFC 6238 TOTP codes. The registrations are signed by the users and a verified
+// registration can only be replaced with a valid code of the current secret. It can be served with any http server,
+// for example httptest.NewServer, so the whole 2FA signing flow can be exercised offline
+type coSignerServer struct {
+	guardianCryptoHolder core.CryptoComponentsHolder
+	txSigner             GuardianTxSigner
+	signatureVerifier    SignatureVerifier
+	keyGenerator         crypto.KeyGenerator
+	addressConverter     core.AddressConverter
+	issuer               string
+	mux                  *http.ServeMux
+	getTimeHandler       func() time.Time
+	mutUsers             sync.RWMutex
+	users                map[string]*registeredUser
+}
+
+// NewCoSignerServer creates a new reference co-signer server
+func NewCoSignerServer(args ArgsCoSignerServer) (*coSignerServer, error) {
+	if check.IfNil(args.GuardianCryptoHolder) {
+		return nil, ErrNilCryptoComponentsHolder
+	}
+	if check.IfNil(args.TxSigner) {
+		return nil, ErrNilGuardianTxSigner
+	}
+	if check.IfNil(args.SignatureVerifier) {
+		return nil, ErrNilSignatureVerifier
+	}
+	if check.IfNil(args.KeyGenerator) {
+		return nil, ErrNilKeyGenerator
+	}
+
+	issuer := args.Issuer
+	if len(issuer) == 0 {
+		issuer = defaultIssuer
+	}
+
+	server := &coSignerServer{
+		guardianCryptoHolder: args.GuardianCryptoHolder,
+		txSigner:             args.TxSigner,
+		signatureVerifier:    args.SignatureVerifier,
+		keyGenerator:         args.KeyGenerator,
+		addressConverter:     core.AddressConverterOrDefault(args.AddressConverter),
+		issuer:               issuer,
+		mux:                  http.NewServeMux(),
+		getTimeHandler:       time.Now,
+		users:                make(map[string]*registeredUser),
+	}
+	server.mux.HandleFunc("/"+registerEndpoint, server.handleRegister)
+	server.mux.HandleFunc("/"+verifyCodeEndpoint, server.handleVerifyCode)
+	server.mux.HandleFunc("/"+signTransactionEndpoint, server.handleSignTransaction)
+
+	return server, nil
+}
+
+// GetGuardianAddress returns the bech32 address of the guardian managed by the server
+func (server *coSignerServer) GetGuardianAddress() string {
+	return server.guardianCryptoHolder.GetBech32()
+}
+
+// ServeHTTP handles the co-signer service requests
+func (server *coSignerServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	server.mux.ServeHTTP(writer, request)
+}
+
+func (server *coSignerServer) handleRegister(writer http.ResponseWriter, request *http.Request) {
+	registerRequest := &RegisterGuardianRequest{}
+	if !decodeRequest(writer, request, registerRequest) {
+		return
+	}
+
+	err := server.checkRegistrationSignature(registerRequest)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+
+	secret, err := GenerateTOTPSecret()
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+
+	userTOTP, err := NewTOTP(ArgsTOTP{
+		Secret: secret,
+		Digits: DefaultTOTPDigits,
+		Period: DefaultTOTPPeriod,
+	})
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+	userTOTP.getTimeHandler = server.getTimeHandler
+
+	err = server.registerUser(registerRequest, userTOTP)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+
+	account := registerRequest.UserAddress
+	if len(registerRequest.Tag) > 0 {
+		account = registerRequest.Tag
+	}
+
+	writeResponse(writer, &RegisterGuardianResponse{
+		OTP: &OTPInfo{
+			Issuer:    server.issuer,
+			Account:   account,
+			Algorithm: TOTPAlgorithm,
+			Digits:    DefaultTOTPDigits,
+			Period:    int(DefaultTOTPPeriod.Seconds()),
+			Secret:    EncodeTOTPSecret(secret),
+		},
+		GuardianAddress: server.GetGuardianAddress(),
+	})
+}
+
+func (server *coSignerServer) checkRegistrationSignature(request *RegisterGuardianRequest) error {
+	registrationTime := time.Unix(request.Timestamp, 0)
+	timeDifference := server.getTimeHandler().Sub(registrationTime)
+	if timeDifference > registrationTimeWindow || timeDifference < -registrationTimeWindow {
+		return fmt.Errorf("%w: timestamp %d", ErrRegistrationExpired, request.Timestamp)
+	}
+
+	publicKey, err := server.getUserPublicKey(request.UserAddress)
+	if err != nil {
+		return err
+	}
+	signature, err := decodeUserSignature(request.Signature)
+	if err != nil {
+		return err
+	}
+
+	message := ComputeRegistrationMessage(request.UserAddress, request.Tag, request.Timestamp)
+	err = server.signatureVerifier.VerifyMessage(message, publicKey, signature)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidUserSignature, err)
+	}
+
+	return nil
+}
+
+// registerUser saves the user's new secret. Registering again resets the secret, the new one has to be verified again.
+// A verified registration can only be replaced with a valid code of the current secret, so a stolen user key is not
+// enough to take over the 2FA
+func (server *coSignerServer) registerUser(request *RegisterGuardianRequest, userTOTP *totp) error {
+	server.mutUsers.Lock()
+	defer server.mutUsers.Unlock()
+
+	user, found := server.users[request.UserAddress]
+	if found {
+		if request.Timestamp <= user.registrationTimestamp {
+			return ErrRegistrationReplayed
+		}
+		if user.verified {
+			err := user.totp.VerifyCode(request.Code)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	server.users[request.UserAddress] = &registeredUser{
+		totp:                  userTOTP,
+		registrationTimestamp: request.Timestamp,
+	}
+
+	return nil
+}
+
+func (server *coSignerServer) checkUserTransactionSignature(tx *transaction.FrontendTransaction) error {
+	publicKey, err := server.getUserPublicKey(tx.Sender)
+	if err != nil {
+		return err
+	}
+	signature, err := decodeUserSignature(tx.Signature)
+	if err != nil {
+		return err
+	}
+
+	dataForSigning, err := server.txSigner.ComputeDataForSigning(tx)
+	if err != nil {
+		return err
+	}
+
+	err = server.signatureVerifier.VerifyByteSlice(dataForSigning, publicKey, signature)
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrInvalidUserSignature, err)
+	}
+
+	return nil
+}
+
+func (server *coSignerServer) getUserPublicKey(bech32Address string) (crypto.PublicKey, error) {
+	address, err := data.NewAddressFromBech32StringWithConverter(bech32Address, server.addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return server.keyGenerator.PublicKeyFromByteArray(address.AddressBytes())
+}
+
+func decodeUserSignature(hexSignature string) ([]byte, error) {
+	if len(hexSignature) == 0 {
+		return nil, ErrMissingUserSignature
+	}
+
+	signature, err := hex.DecodeString(hexSignature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidUserSignature, err)
+	}
+
+	return signature, nil
+}
+
+func (server *coSignerServer) handleVerifyCode(writer http.ResponseWriter, request *http.Request) {
+	verifyRequest := &VerifyCodeRequest{}
+	if !decodeRequest(writer, request, verifyRequest) {
+		return
+	}
+	if verifyRequest.Guardian != server.GetGuardianAddress() {
+		writeError(writer, ErrGuardianMismatch)
+		return
+	}
+
+	server.mutUsers.Lock()
+	defer server.mutUsers.Unlock()
+
+	user, found := server.users[verifyRequest.UserAddress]
+	if !found {
+		writeError(writer, ErrUserNotRegistered)
+		return
+	}
+
+	err := user.totp.VerifyCode(verifyRequest.Code)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+
+	user.verified = true
+	writeResponse(writer, struct{}{})
+}
+
+func (server *coSignerServer) handleSignTransaction(writer http.ResponseWriter, request *http.Request) {
+	signRequest := &SignTransactionRequest{}
+	if !decodeRequest(writer, request, signRequest) {
+		return
+	}
+
+	tx := signRequest.Transaction
+	if tx == nil {
+		writeError(writer, ErrNilTransaction)
+		return
+	}
+	if tx.GuardianAddr != server.GetGuardianAddress() {
+		writeError(writer, ErrGuardianMismatch)
+		return
+	}
+
+	server.mutUsers.RLock()
+	user, found := server.users[tx.Sender]
+	server.mutUsers.RUnlock()
+	if !found {
+		writeError(writer, ErrUserNotRegistered)
+		return
+	}
+	if !user.verified {
+		writeError(writer, ErrRegistrationNotVerified)
+		return
+	}
+
+	// the user signature is checked before the code so an invalid transaction does not consume the code
+	err := server.checkUserTransactionSignature(tx)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+
+	err = user.totp.VerifyCode(signRequest.Code)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+
+	err = server.txSigner.ApplyGuardianSignature(server.guardianCryptoHolder, tx)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+
+	writeResponse(writer, &SignTransactionResponse{
+		Transaction: tx,
+	})
+}
+
+func decodeRequest(writer http.ResponseWriter, request *http.Request, obj interface{}) bool {
+	if request.Method != http.MethodPost {
+		writeError(writer, fmt.Errorf("method %s not allowed", request.Method))
+		return false
+	}
+
+	err := json.NewDecoder(request.Body).Decode(obj)
+	if err != nil {
+		writeError(writer, err)
+		return false
+	}
+
+	return true
+}
+
+func writeResponse(writer http.ResponseWriter, responseData interface{}) {
+	dataBytes, err := json.Marshal(responseData)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, &coSignerResponse{
+		Data: dataBytes,
+		Code: responseCodeSuccessful,
+	})
+}
+
+func writeError(writer http.ResponseWriter, err error) {
+	writeJSON(writer, http.StatusBadRequest, &coSignerResponse{
+		Error: strings.TrimSpace(err.Error()),
+		Code:  responseCodeBadRequest,
+	})
+}
+
+func writeJSON(writer http.ResponseWriter, statusCode int, response *coSignerResponse) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(statusCode)
+	_ = json.NewEncoder(writer).Encode(response)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (server *coSignerServer) IsInterfaceNil() bool {
+	return server == nil
+}
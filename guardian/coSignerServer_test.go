@@ -0,0 +1,378 @@
+package guardian
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/core"
+	sdkHttp "github.com/multiversx/mx-sdk-go/core/http"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/multiversx/mx-sdk-go/txcheck"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var keyGen = signing.NewKeyGenerator(ed25519.NewEd25519())
+
+func createCryptoHolder(t *testing.T, hexSk string) core.CryptoComponentsHolder {
+	sk, err := hex.DecodeString(hexSk)
+	require.Nil(t, err)
+
+	holder, err := cryptoProvider.NewCryptoComponentsHolder(keyGen, sk)
+	require.Nil(t, err)
+
+	return holder
+}
+
+func createMockArgsCoSignerServer() ArgsCoSignerServer {
+	return ArgsCoSignerServer{
+		GuardianCryptoHolder: &testsCommon.CryptoComponentsHolderStub{},
+		TxSigner:             &testsCommon.TxBuilderStub{},
+		SignatureVerifier:    &testsCommon.SignerStub{},
+		KeyGenerator:         keyGen,
+	}
+}
+
+// testClock is a manually advanced clock shared by the server and the authenticator
+type testClock struct {
+	unixTime atomic.Int64
+}
+
+func newTestClock() *testClock {
+	clock := &testClock{}
+	clock.unixTime.Store(time.Now().Unix())
+
+	return clock
+}
+
+func (clock *testClock) now() time.Time {
+	return time.Unix(clock.unixTime.Load(), 0)
+}
+
+func (clock *testClock) advance(duration time.Duration) {
+	clock.unixTime.Add(int64(duration.Seconds()))
+}
+
+func TestNewCoSignerServer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil guardian crypto holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCoSignerServer()
+		args.GuardianCryptoHolder = nil
+		server, err := NewCoSignerServer(args)
+		assert.True(t, check.IfNil(server))
+		assert.Equal(t, ErrNilCryptoComponentsHolder, err)
+	})
+	t.Run("nil tx signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCoSignerServer()
+		args.TxSigner = nil
+		server, err := NewCoSignerServer(args)
+		assert.True(t, check.IfNil(server))
+		assert.Equal(t, ErrNilGuardianTxSigner, err)
+	})
+	t.Run("nil signature verifier should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCoSignerServer()
+		args.SignatureVerifier = nil
+		server, err := NewCoSignerServer(args)
+		assert.True(t, check.IfNil(server))
+		assert.Equal(t, ErrNilSignatureVerifier, err)
+	})
+	t.Run("nil key generator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsCoSignerServer()
+		args.KeyGenerator = nil
+		server, err := NewCoSignerServer(args)
+		assert.True(t, check.IfNil(server))
+		assert.Equal(t, ErrNilKeyGenerator, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		server, err := NewCoSignerServer(createMockArgsCoSignerServer())
+		assert.False(t, check.IfNil(server))
+		assert.Nil(t, err)
+	})
+}
+
+func TestCoSignerClientAndServer_TwoFactorSigningFlow(t *testing.T) {
+	t.Parallel()
+
+	userHolder := createCryptoHolder(t, "6ae10fed53a84029e53e35afdbe083688eea0917a09a9431951dd42fd4da14c4")
+	guardianHolder := createCryptoHolder(t, "28654d9264f55f18d810bb88617e22c117df94fa684dfe341a511a72dfbf2b68")
+	signer := cryptoProvider.NewSigner()
+	txBuilder, err := builders.NewTxBuilder(signer)
+	require.Nil(t, err)
+	userSigner, err := builders.NewCryptoHolderTransactionSigner(signer, userHolder)
+	require.Nil(t, err)
+
+	server, err := NewCoSignerServer(ArgsCoSignerServer{
+		GuardianCryptoHolder: guardianHolder,
+		TxSigner:             txBuilder,
+		SignatureVerifier:    signer,
+		KeyGenerator:         keyGen,
+	})
+	require.Nil(t, err)
+	clock := newTestClock()
+	server.getTimeHandler = clock.now
+	httpServer := httptest.NewServer(server)
+	defer httpServer.Close()
+
+	client, err := NewCoSignerClient(ArgsCoSignerClient{
+		HttpClientWrapper: sdkHttp.NewHttpClientWrapper(nil, httpServer.URL),
+	})
+	require.Nil(t, err)
+	client.getTimeHandler = clock.now
+	ctx := context.Background()
+
+	// register & verify the guardian
+	_, err = client.RegisterGuardian(ctx, nil, "my wallet", "")
+	assert.Equal(t, ErrNilTransactionSigner, err)
+
+	registration, err := client.RegisterGuardian(ctx, userSigner, "my wallet", "")
+	require.Nil(t, err)
+	assert.Equal(t, guardianHolder.GetBech32(), registration.GuardianAddress)
+	assert.Contains(t, registration.OTP.URL(), "otpauth://totp/MultiversX:my%20wallet?")
+
+	secret, err := DecodeTOTPSecret(registration.OTP.Secret)
+	require.Nil(t, err)
+	authenticator, err := NewTOTP(ArgsTOTP{
+		Secret: secret,
+		Digits: registration.OTP.Digits,
+		Period: DefaultTOTPPeriod,
+	})
+	require.Nil(t, err)
+	authenticator.getTimeHandler = clock.now
+
+	tx := &transaction.FrontendTransaction{
+		Nonce:        1,
+		Value:        "1000",
+		Receiver:     guardianHolder.GetBech32(),
+		Sender:       userHolder.GetBech32(),
+		GasPrice:     1000000000,
+		GasLimit:     100000,
+		ChainID:      "T",
+		Version:      2,
+		GuardianAddr: registration.GuardianAddress,
+		Options:      transaction.MaskGuardedTransaction,
+	}
+	err = txBuilder.ApplyUserSignature(userHolder, tx)
+	require.Nil(t, err)
+
+	code, _ := authenticator.GetCode()
+	_, err = client.SignTransaction(ctx, tx, code)
+	assert.ErrorContains(t, err, ErrRegistrationNotVerified.Error())
+
+	err = client.VerifyCode(ctx, userHolder.GetBech32(), registration.GuardianAddress, "000000x")
+	assert.ErrorContains(t, err, ErrInvalidTOTPCode.Error())
+
+	err = client.VerifyCode(ctx, userHolder.GetBech32(), registration.GuardianAddress, code)
+	require.Nil(t, err)
+
+	// co-sign without a code provider
+	err = client.CoSignTransaction(ctx, tx)
+	assert.Equal(t, ErrNilCodeProvider, err)
+
+	// the code used for the verification can not be used again
+	_, err = client.SignTransaction(ctx, tx, code)
+	assert.ErrorContains(t, err, ErrTOTPCodeAlreadyUsed.Error())
+
+	// co-sign with the authenticator as code provider
+	clock.advance(DefaultTOTPPeriod)
+	client, _ = NewCoSignerClient(ArgsCoSignerClient{
+		HttpClientWrapper: sdkHttp.NewHttpClientWrapper(nil, httpServer.URL),
+		CodeProvider:      authenticator,
+	})
+	client.getTimeHandler = clock.now
+	err = client.CoSignTransaction(ctx, tx)
+	require.Nil(t, err)
+	require.NotEmpty(t, tx.GuardianSignature)
+
+	guardianSignature, _ := hex.DecodeString(tx.GuardianSignature)
+	err = txcheck.VerifyTransactionSignature(
+		tx,
+		guardianHolder.GetPublicKey(),
+		guardianSignature,
+		signer,
+		&testsCommon.MarshalizerMock{},
+		blake2b.NewBlake2b(),
+	)
+	assert.Nil(t, err)
+
+	// a transaction altered after the user signed it is rejected
+	clock.advance(DefaultTOTPPeriod)
+	alteredTx := *tx
+	alteredTx.Value = "1000000"
+	err = client.CoSignTransaction(ctx, &alteredTx)
+	assert.ErrorContains(t, err, ErrInvalidUserSignature.Error())
+
+	// a transaction without the user signature is rejected
+	unsignedTx := *tx
+	unsignedTx.Signature = ""
+	err = client.CoSignTransaction(ctx, &unsignedTx)
+	assert.ErrorContains(t, err, ErrMissingUserSignature.Error())
+
+	// a transaction guarded by another guardian is rejected
+	tx.GuardianAddr = userHolder.GetBech32()
+	err = client.CoSignTransaction(ctx, tx)
+	assert.ErrorContains(t, err, ErrGuardianMismatch.Error())
+
+	// the verified registration can not be replaced with the user key only
+	_, err = client.RegisterGuardian(ctx, userSigner, "my wallet", "")
+	assert.ErrorContains(t, err, ErrInvalidTOTPCode.Error())
+
+	// but it can be replaced with a valid code of the current secret
+	clock.advance(DefaultTOTPPeriod)
+	code, _ = authenticator.GetCode()
+	newRegistration, err := client.RegisterGuardian(ctx, userSigner, "my wallet", code)
+	require.Nil(t, err)
+	assert.NotEqual(t, registration.OTP.Secret, newRegistration.OTP.Secret)
+}
+
+func TestCoSignerServer_Register(t *testing.T) {
+	t.Parallel()
+
+	userHolder := createCryptoHolder(t, "6ae10fed53a84029e53e35afdbe083688eea0917a09a9431951dd42fd4da14c4")
+	attackerHolder := createCryptoHolder(t, "28654d9264f55f18d810bb88617e22c117df94fa684dfe341a511a72dfbf2b68")
+	signer := cryptoProvider.NewSigner()
+
+	createServer := func() *coSignerServer {
+		txBuilder, _ := builders.NewTxBuilder(signer)
+		server, err := NewCoSignerServer(ArgsCoSignerServer{
+			GuardianCryptoHolder: createCryptoHolder(t, "45f72e8b6e8d10086bacd2fc8fa1340f82a3f5d4ef31953b463ea03c606533a6"),
+			TxSigner:             txBuilder,
+			SignatureVerifier:    signer,
+			KeyGenerator:         keyGen,
+		})
+		require.Nil(t, err)
+
+		return server
+	}
+	createRequest := func(holder core.CryptoComponentsHolder, userAddress string, timestamp int64) *RegisterGuardianRequest {
+		message := ComputeRegistrationMessage(userAddress, "tag", timestamp)
+		signature, err := signer.SignMessage(message, holder.GetPrivateKey())
+		require.Nil(t, err)
+
+		return &RegisterGuardianRequest{
+			UserAddress: userAddress,
+			Tag:         "tag",
+			Timestamp:   timestamp,
+			Signature:   hex.EncodeToString(signature),
+		}
+	}
+	register := func(server *coSignerServer, request *RegisterGuardianRequest) error {
+		httpServer := httptest.NewServer(server)
+		defer httpServer.Close()
+
+		requestBytes, _ := json.Marshal(request)
+		buff, _, err := sdkHttp.NewHttpClientWrapper(nil, httpServer.URL).PostHTTP(context.Background(), registerEndpoint, requestBytes)
+		require.Nil(t, err)
+
+		response := &coSignerResponse{}
+		require.Nil(t, json.Unmarshal(buff, response))
+		if len(response.Error) > 0 {
+			return &registrationError{message: response.Error}
+		}
+
+		return nil
+	}
+
+	t.Run("missing signature should error", func(t *testing.T) {
+		t.Parallel()
+
+		request := createRequest(userHolder, userHolder.GetBech32(), time.Now().Unix())
+		request.Signature = ""
+		err := register(createServer(), request)
+		assert.ErrorContains(t, err, ErrMissingUserSignature.Error())
+	})
+	t.Run("signature of another key should error", func(t *testing.T) {
+		t.Parallel()
+
+		request := createRequest(attackerHolder, userHolder.GetBech32(), time.Now().Unix())
+		err := register(createServer(), request)
+		assert.ErrorContains(t, err, ErrInvalidUserSignature.Error())
+	})
+	t.Run("expired registration should error", func(t *testing.T) {
+		t.Parallel()
+
+		request := createRequest(userHolder, userHolder.GetBech32(), time.Now().Add(-2*registrationTimeWindow).Unix())
+		err := register(createServer(), request)
+		assert.ErrorContains(t, err, ErrRegistrationExpired.Error())
+	})
+	t.Run("custom address converter should work", func(t *testing.T) {
+		t.Parallel()
+
+		converter, err := core.NewAddressPublicKeyConverter("sov")
+		require.Nil(t, err)
+		userPublicKey, _ := userHolder.GetPublicKey().ToByteArray()
+		userAddress, err := converter.Encode(userPublicKey)
+		require.Nil(t, err)
+		request := createRequest(userHolder, userAddress, time.Now().Unix())
+
+		err = register(createServer(), request)
+		assert.NotNil(t, err)
+
+		txBuilder, _ := builders.NewTxBuilder(signer)
+		server, err := NewCoSignerServer(ArgsCoSignerServer{
+			GuardianCryptoHolder: createCryptoHolder(t, "45f72e8b6e8d10086bacd2fc8fa1340f82a3f5d4ef31953b463ea03c606533a6"),
+			TxSigner:             txBuilder,
+			SignatureVerifier:    signer,
+			KeyGenerator:         keyGen,
+			AddressConverter:     converter,
+		})
+		require.Nil(t, err)
+
+		err = register(server, request)
+		assert.Nil(t, err)
+	})
+	t.Run("replayed registration should error", func(t *testing.T) {
+		t.Parallel()
+
+		server := createServer()
+		request := createRequest(userHolder, userHolder.GetBech32(), time.Now().Unix())
+		err := register(server, request)
+		require.Nil(t, err)
+
+		err = register(server, request)
+		assert.ErrorContains(t, err, ErrRegistrationReplayed.Error())
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		server := createServer()
+		request := createRequest(userHolder, userHolder.GetBech32(), time.Now().Unix())
+		err := register(server, request)
+		require.Nil(t, err)
+
+		// an unverified registration can be replaced by a newer one
+		request = createRequest(userHolder, userHolder.GetBech32(), time.Now().Unix()+1)
+		err = register(server, request)
+		require.Nil(t, err)
+	})
+}
+
+type registrationError struct {
+	message string
+}
+
+func (err *registrationError) Error() string {
+	return err.message
+}
@@ -0,0 +1,90 @@
+package guardian
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+const (
+	registerEndpoint        = "guardian/register"
+	verifyCodeEndpoint      = "guardian/verify-code"
+	signTransactionEndpoint = "guardian/sign-transaction"
+
+	responseCodeSuccessful = "successful"
+	responseCodeBadRequest = "bad_request"
+)
+
+// RegisterGuardianRequest is the request used to register a guardian on the co-signer service. The signature is the
+// user's message signature over the registration message, proving the ownership of the address. The code is only
+// required when re-registering a user with a verified registration and it has to be valid for the current secret
+type RegisterGuardianRequest struct {
+	UserAddress string `json:"userAddress"`
+	Tag         string `json:"tag"`
+	Timestamp   int64  `json:"timestamp"`
+	Signature   string `json:"signature"`
+	Code        string `json:"code,omitempty"`
+}
+
+// ComputeRegistrationMessage returns the message signed by the user when registering a guardian. The timestamp (in
+// unix seconds) limits the time window in which the registration is accepted
+func ComputeRegistrationMessage(userAddress string, tag string, timestamp int64) []byte {
+	return []byte(fmt.Sprintf("register co-signer guardian\naddress: %s\ntag: %s\ntimestamp: %d", userAddress, tag, timestamp))
+}
+
+// OTPInfo holds the details needed to configure an authenticator application
+type OTPInfo struct {
+	Issuer    string `json:"issuer"`
+	Account   string `json:"account"`
+	Algorithm string `json:"algorithm"`
+	Digits    int    `json:"digits"`
+	Period    int    `json:"period"`
+	Secret    string `json:"secret"`
+}
+
+// URL returns the otpauth:// key URI, usually displayed as a QR code
+func (info *OTPInfo) URL() string {
+	values := url.Values{}
+	values.Set("secret", info.Secret)
+	values.Set("issuer", info.Issuer)
+	values.Set("algorithm", info.Algorithm)
+	values.Set("digits", fmt.Sprintf("%d", info.Digits))
+	values.Set("period", fmt.Sprintf("%d", info.Period))
+
+	label := url.PathEscape(fmt.Sprintf("%s:%s", info.Issuer, info.Account))
+
+	return fmt.Sprintf("otpauth://totp/%s?%s", label, values.Encode())
+}
+
+// RegisterGuardianResponse is the co-signer service response to a guardian registration
+type RegisterGuardianResponse struct {
+	OTP             *OTPInfo `json:"otp"`
+	GuardianAddress string   `json:"guardianAddress"`
+}
+
+// VerifyCodeRequest is the request used to confirm a guardian registration with a valid code
+type VerifyCodeRequest struct {
+	UserAddress string `json:"userAddress"`
+	Guardian    string `json:"guardian"`
+	Code        string `json:"code"`
+}
+
+// SignTransactionRequest is the request used to ask for the co-signature of a transaction
+type SignTransactionRequest struct {
+	Code        string                           `json:"code"`
+	Transaction *transaction.FrontendTransaction `json:"transaction"`
+}
+
+// SignTransactionResponse is the co-signer service response holding the co-signed transaction
+type SignTransactionResponse struct {
+	Transaction *transaction.FrontendTransaction `json:"transaction"`
+}
+
+// coSignerResponse is the generic co-signer service response
+type coSignerResponse struct {
+	Data  json.RawMessage `json:"data"`
+	Error string          `json:"error"`
+	Code  string          `json:"code"`
+}
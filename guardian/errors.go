@@ -0,0 +1,79 @@
+package guardian
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrEmptyTOTPSecret signals that an empty TOTP secret was provided
+var ErrEmptyTOTPSecret = errors.New("empty TOTP secret")
+
+// ErrInvalidTOTPConfig signals that an invalid TOTP configuration was provided
+var ErrInvalidTOTPConfig = errors.New("invalid TOTP config")
+
+// ErrInvalidTOTPCode signals that the provided TOTP code is not valid
+var ErrInvalidTOTPCode = errors.New("invalid TOTP code")
+
+// ErrTOTPCodeAlreadyUsed signals that the provided TOTP code belongs to an already used time step
+var ErrTOTPCodeAlreadyUsed = errors.New("TOTP code already used")
+
+// ErrNilHttpClientWrapper signals that a nil http client wrapper was provided
+var ErrNilHttpClientWrapper = errors.New("nil http client wrapper")
+
+// ErrNilCodeProvider signals that a nil code provider was provided
+var ErrNilCodeProvider = errors.New("nil code provider")
+
+// ErrNilCryptoComponentsHolder signals that a nil crypto components holder was provided
+var ErrNilCryptoComponentsHolder = errors.New("nil crypto components holder")
+
+// ErrNilGuardianTxSigner signals that a nil guardian transaction signer was provided
+var ErrNilGuardianTxSigner = errors.New("nil guardian transaction signer")
+
+// ErrNilSignatureVerifier signals that a nil signature verifier was provided
+var ErrNilSignatureVerifier = errors.New("nil signature verifier")
+
+// ErrNilKeyGenerator signals that a nil key generator was provided
+var ErrNilKeyGenerator = errors.New("nil key generator")
+
+// ErrNilTransactionSigner signals that a nil transaction signer was provided
+var ErrNilTransactionSigner = errors.New("nil transaction signer")
+
+// ErrMissingUserSignature signals that the user signature is missing
+var ErrMissingUserSignature = errors.New("missing user signature")
+
+// ErrInvalidUserSignature signals that the user signature is not valid
+var ErrInvalidUserSignature = errors.New("invalid user signature")
+
+// ErrRegistrationExpired signals that the registration timestamp is outside the accepted time window
+var ErrRegistrationExpired = errors.New("registration expired")
+
+// ErrRegistrationReplayed signals that the registration is not newer than the user's current registration
+var ErrRegistrationReplayed = errors.New("registration replayed")
+
+// ErrNilTransaction signals that a nil transaction was provided
+var ErrNilTransaction = errors.New("nil transaction")
+
+// ErrUserNotRegistered signals that the user did not register a guardian on the co-signer service
+var ErrUserNotRegistered = errors.New("user not registered")
+
+// ErrRegistrationNotVerified signals that the user did not verify the guardian registration with a valid code
+var ErrRegistrationNotVerified = errors.New("registration not verified")
+
+// ErrGuardianMismatch signals that the transaction's guardian is not the co-signer's guardian
+var ErrGuardianMismatch = errors.New("guardian mismatch")
+
+// ErrCoSignedTransactionMismatch signals that the co-signed transaction differs from the one sent for co-signing
+var ErrCoSignedTransactionMismatch = errors.New("co-signed transaction mismatch")
+
+// ErrHTTPStatusCodeIsNotOK signals that the returned HTTP status code is not OK
+var ErrHTTPStatusCodeIsNotOK = errors.New("HTTP status code is not OK")
+
+func createHTTPStatusError(httpStatusCode int, err error) error {
+	if err == nil {
+		err = ErrHTTPStatusCodeIsNotOK
+	}
+
+	return fmt.Errorf("%w, returned http status: %d, %s",
+		err, httpStatusCode, http.StatusText(httpStatusCode))
+}
@@ -0,0 +1,45 @@
+package guardian
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+// HttpClientWrapper defines the behavior of http client able to make http requests
+type HttpClientWrapper interface {
+	GetHTTP(ctx context.Context, endpoint string) ([]byte, int, error)
+	PostHTTP(ctx context.Context, endpoint string, data []byte) ([]byte, int, error)
+	IsInterfaceNil() bool
+}
+
+// CodeProvider defines the component able to provide the current 2FA code (for example, a TOTP generator or a user prompt)
+type CodeProvider interface {
+	GetCode() (string, error)
+	IsInterfaceNil() bool
+}
+
+// GuardianTxSigner defines the component able to apply the guardian signature on a transaction
+type GuardianTxSigner interface {
+	ApplyGuardianSignature(guardianCryptoHolder core.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error
+	ComputeDataForSigning(tx *transaction.FrontendTransaction) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+// SignatureVerifier defines the component able to verify the users' message and transaction signatures
+type SignatureVerifier interface {
+	VerifyMessage(msg []byte, publicKey crypto.PublicKey, sig []byte) error
+	VerifyByteSlice(msg []byte, publicKey crypto.PublicKey, sig []byte) error
+	IsInterfaceNil() bool
+}
+
+// CoSignerClient defines the behavior of a trusted co-signer service client
+type CoSignerClient interface {
+	RegisterGuardian(ctx context.Context, userSigner core.TransactionSigner, tag string, code string) (*RegisterGuardianResponse, error)
+	VerifyCode(ctx context.Context, userAddress string, guardian string, code string) error
+	SignTransaction(ctx context.Context, tx *transaction.FrontendTransaction, code string) (*transaction.FrontendTransaction, error)
+	CoSignTransaction(ctx context.Context, tx *transaction.FrontendTransaction) error
+	IsInterfaceNil() bool
+}
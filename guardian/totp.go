@@ -0,0 +1,159 @@
+package guardian
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base32"
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// DefaultTOTPDigits is the default number of digits of a TOTP code
+	DefaultTOTPDigits = 6
+	// DefaultTOTPPeriod is the default time step of a TOTP code
+	DefaultTOTPPeriod = 30 * time.Second
+	// TOTPAlgorithm is the HMAC algorithm used to compute the TOTP codes
+	TOTPAlgorithm = "SHA1"
+
+	secretLength = 20
+	maxDigits    = 10
+	// the number of time steps before and after the current one that are accepted, to allow for clock drift
+	allowedTimeSteps = 1
+)
+
+var base32Encoding = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// ArgsTOTP is the DTO used in the TOTP constructor
+type ArgsTOTP struct {
+	Secret []byte
+	Digits int
+	Period time.Duration
+}
+
+// totp implements the RFC 6238 time-based one-time password algorithm, using HMAC-SHA1
+type totp struct {
+	secret         []byte
+	digits         int
+	period         time.Duration
+	getTimeHandler func() time.Time
+
+	mutCounter          sync.Mutex
+	lastAcceptedCounter uint64
+}
+
+// NewTOTP creates a new RFC 6238 TOTP generator and verifier
+func NewTOTP(args ArgsTOTP) (*totp, error) {
+	if len(args.Secret) == 0 {
+		return nil, ErrEmptyTOTPSecret
+	}
+	if args.Digits <= 0 || args.Digits > maxDigits {
+		return nil, fmt.Errorf("%w: %d digits", ErrInvalidTOTPConfig, args.Digits)
+	}
+	if args.Period < time.Second {
+		return nil, fmt.Errorf("%w: period %v", ErrInvalidTOTPConfig, args.Period)
+	}
+
+	return &totp{
+		secret:         args.Secret,
+		digits:         args.Digits,
+		period:         args.Period,
+		getTimeHandler: time.Now,
+	}, nil
+}
+
+// GetCode returns the TOTP code for the current time
+func (t *totp) GetCode() (string, error) {
+	return t.GenerateCode(t.getTimeHandler()), nil
+}
+
+// GenerateCode returns the TOTP code for the provided time
+func (t *totp) GenerateCode(timestamp time.Time) string {
+	return t.generateCodeForCounter(t.counter(timestamp))
+}
+
+// VerifyCode checks the provided code against the codes of the current time step and its neighbours. As required by
+// RFC 6238, section 5.2, a code is accepted only once: the codes of the last accepted time step and of the ones
+// before it are rejected
+func (t *totp) VerifyCode(code string) error {
+	currentCounter := t.counter(t.getTimeHandler())
+	for delta := -allowedTimeSteps; delta <= allowedTimeSteps; delta++ {
+		counter := uint64(int64(currentCounter) + int64(delta))
+		expectedCode := t.generateCodeForCounter(counter)
+		if subtle.ConstantTimeCompare([]byte(expectedCode), []byte(code)) == 1 {
+			return t.acceptCounter(counter)
+		}
+	}
+
+	return ErrInvalidTOTPCode
+}
+
+func (t *totp) acceptCounter(counter uint64) error {
+	t.mutCounter.Lock()
+	defer t.mutCounter.Unlock()
+
+	if counter <= t.lastAcceptedCounter {
+		return ErrTOTPCodeAlreadyUsed
+	}
+	t.lastAcceptedCounter = counter
+
+	return nil
+}
+
+func (t *totp) counter(timestamp time.Time) uint64 {
+	return uint64(timestamp.Unix()) / uint64(t.period.Seconds())
+}
+
+// generateCodeForCounter implements the HOTP algorithm from RFC 4226, section 5.3
+func (t *totp) generateCodeForCounter(counter uint64) string {
+	counterBytes := make([]byte, 8)
+	binary.BigEndian.PutUint64(counterBytes, counter)
+
+	mac := hmac.New(sha1.New, t.secret)
+	_, _ = mac.Write(counterBytes)
+	sum := mac.Sum(nil)
+
+	offset := sum[len(sum)-1] & 0x0f
+	binaryCode := binary.BigEndian.Uint32(sum[offset:offset+4]) & 0x7fffffff
+
+	modulo := uint64(1)
+	for i := 0; i < t.digits; i++ {
+		modulo *= 10
+	}
+
+	return fmt.Sprintf("%0*d", t.digits, uint64(binaryCode)%modulo)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (t *totp) IsInterfaceNil() bool {
+	return t == nil
+}
+
+// GenerateTOTPSecret generates a new random TOTP secret
+func GenerateTOTPSecret() ([]byte, error) {
+	secret := make([]byte, secretLength)
+	_, err := rand.Read(secret)
+	if err != nil {
+		return nil, err
+	}
+
+	return secret, nil
+}
+
+// EncodeTOTPSecret encodes the secret in the base32 format used by the authenticator applications
+func EncodeTOTPSecret(secret []byte) string {
+	return base32Encoding.EncodeToString(secret)
+}
+
+// DecodeTOTPSecret decodes the base32 secret, as shown by the authenticator applications
+func DecodeTOTPSecret(secret string) ([]byte, error) {
+	cleaned := strings.ToUpper(strings.ReplaceAll(secret, " ", ""))
+	cleaned = strings.TrimRight(cleaned, "=")
+
+	return base32Encoding.DecodeString(cleaned)
+}
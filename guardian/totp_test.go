@@ -0,0 +1,109 @@
+package guardian
+
+import (
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var rfc6238Secret = []byte("12345678901234567890")
+
+func TestNewTOTP(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty secret should error", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewTOTP(ArgsTOTP{Digits: 6, Period: time.Second})
+		assert.True(t, check.IfNil(instance))
+		assert.Equal(t, ErrEmptyTOTPSecret, err)
+	})
+	t.Run("invalid digits should error", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewTOTP(ArgsTOTP{Secret: rfc6238Secret, Digits: 11, Period: time.Second})
+		assert.True(t, check.IfNil(instance))
+		assert.ErrorIs(t, err, ErrInvalidTOTPConfig)
+	})
+	t.Run("invalid period should error", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewTOTP(ArgsTOTP{Secret: rfc6238Secret, Digits: 6, Period: time.Millisecond})
+		assert.True(t, check.IfNil(instance))
+		assert.ErrorIs(t, err, ErrInvalidTOTPConfig)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		instance, err := NewTOTP(ArgsTOTP{Secret: rfc6238Secret, Digits: 6, Period: time.Second})
+		assert.False(t, check.IfNil(instance))
+		assert.Nil(t, err)
+	})
+}
+
+func TestTOTP_GenerateCodeRFC6238Vectors(t *testing.T) {
+	t.Parallel()
+
+	instance, err := NewTOTP(ArgsTOTP{Secret: rfc6238Secret, Digits: 8, Period: DefaultTOTPPeriod})
+	require.Nil(t, err)
+
+	// SHA1 test vectors from RFC 6238, appendix B
+	vectors := map[int64]string{
+		59:          "94287082",
+		1111111109:  "07081804",
+		1111111111:  "14050471",
+		1234567890:  "89005924",
+		2000000000:  "69279037",
+		20000000000: "65353130",
+	}
+	for unixTime, expectedCode := range vectors {
+		assert.Equal(t, expectedCode, instance.GenerateCode(time.Unix(unixTime, 0)), "time %d", unixTime)
+	}
+}
+
+func TestTOTP_VerifyCode(t *testing.T) {
+	t.Parallel()
+
+	instance, _ := NewTOTP(ArgsTOTP{Secret: rfc6238Secret, Digits: DefaultTOTPDigits, Period: DefaultTOTPPeriod})
+	now := time.Unix(1234567890, 0)
+	instance.getTimeHandler = func() time.Time {
+		return now
+	}
+
+	code, err := instance.GetCode()
+	require.Nil(t, err)
+	assert.Len(t, code, DefaultTOTPDigits)
+
+	assert.Equal(t, ErrInvalidTOTPCode, instance.VerifyCode(instance.GenerateCode(now.Add(3*DefaultTOTPPeriod))))
+	assert.Equal(t, ErrInvalidTOTPCode, instance.VerifyCode(""))
+
+	// the previous and the next time steps are accepted
+	previousCode := instance.GenerateCode(now.Add(-DefaultTOTPPeriod))
+	assert.Nil(t, instance.VerifyCode(previousCode))
+	assert.Nil(t, instance.VerifyCode(code))
+	assert.Nil(t, instance.VerifyCode(instance.GenerateCode(now.Add(DefaultTOTPPeriod))))
+
+	// the codes of the accepted time steps and of the ones before them can not be used again
+	assert.Equal(t, ErrTOTPCodeAlreadyUsed, instance.VerifyCode(code))
+	assert.Equal(t, ErrTOTPCodeAlreadyUsed, instance.VerifyCode(previousCode))
+}
+
+func TestTOTPSecretEncoding(t *testing.T) {
+	t.Parallel()
+
+	secret, err := GenerateTOTPSecret()
+	require.Nil(t, err)
+	assert.Len(t, secret, secretLength)
+
+	encoded := EncodeTOTPSecret(secret)
+	decoded, err := DecodeTOTPSecret(encoded)
+	require.Nil(t, err)
+	assert.Equal(t, secret, decoded)
+
+	decoded, err = DecodeTOTPSecret("gezd gnbv gy3t qojq gezd gnbv gy3t qojq")
+	require.Nil(t, err)
+	assert.Equal(t, rfc6238Secret, decoded)
+}
@@ -37,3 +37,51 @@ var ErrGapNonce = errors.New("gap nonce detected")
 
 // ErrWorkerClosed signals that the worker is closed
 var ErrWorkerClosed = errors.New("worker closed")
+
+// ErrNilNetworkConfig signals that a nil network config was provided
+var ErrNilNetworkConfig = errors.New("nil network config")
+
+// ErrNilTransactionCostRequester signals that a nil transaction cost requester was provided
+var ErrNilTransactionCostRequester = errors.New("nil transaction cost requester")
+
+// ErrInvalidSafetyMargin signals that an invalid gas safety margin was provided
+var ErrInvalidSafetyMargin = errors.New("invalid gas safety margin")
+
+// ErrTransactionCostFailed signals that the transaction cost simulation failed
+var ErrTransactionCostFailed = errors.New("transaction cost simulation failed")
+
+// ErrUnknownKeystoreKind signals that the keystore has an unknown or unexpected kind
+var ErrUnknownKeystoreKind = errors.New("unknown keystore kind")
+
+// ErrInvalidKDFParams signals that invalid key derivation function parameters were provided
+var ErrInvalidKDFParams = errors.New("invalid KDF parameters")
+
+// ErrInvalidMnemonic signals that an invalid mnemonic was provided
+var ErrInvalidMnemonic = errors.New("invalid mnemonic")
+
+// ErrPemKeyNotFound signals that the requested key was not found in the .pem file
+var ErrPemKeyNotFound = errors.New("key not found in the .PEM file")
+
+// ErrInvalidDerivationRange signals that the requested derivation range is outside the hardened indexes range
+var ErrInvalidDerivationRange = errors.New("invalid derivation range")
+
+// ErrInvalidGapLimit signals that an invalid gap limit was provided
+var ErrInvalidGapLimit = errors.New("invalid gap limit")
+
+// ErrMnemonicChecksum signals that the mnemonic words are valid but its checksum is not
+var ErrMnemonicChecksum = errors.New("invalid mnemonic checksum")
+
+// ErrUnsupportedMnemonicLanguage signals that the mnemonic language is not supported
+var ErrUnsupportedMnemonicLanguage = errors.New("unsupported mnemonic language")
+
+// ErrNilShardCoordinator signals that a nil shard coordinator was provided
+var ErrNilShardCoordinator = errors.New("nil shard coordinator")
+
+// ErrInvalidTargetShard signals that the target shard is not a shard a user address can belong to
+var ErrInvalidTargetShard = errors.New("invalid target shard")
+
+// ErrInvalidVanityPattern signals that the vanity prefix or suffix holds characters outside the bech32 charset
+var ErrInvalidVanityPattern = errors.New("invalid vanity pattern")
+
+// ErrInvalidNumberOfAddresses signals that an invalid number of addresses was requested
+var ErrInvalidNumberOfAddresses = errors.New("invalid number of addresses")
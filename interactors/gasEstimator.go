@@ -0,0 +1,144 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const (
+	// DefaultGasSafetyMarginPercent is the recommended safety margin added over the simulated gas cost of a contract call
+	DefaultGasSafetyMarginPercent = 10
+
+	maxGasSafetyMarginPercent = 100
+	percentDivisor            = 100
+)
+
+// ArgsGasEstimator is the DTO used in the gas estimator constructor
+type ArgsGasEstimator struct {
+	NetworkConfig       *data.NetworkConfig
+	CostRequester       TransactionCostRequester
+	SafetyMarginPercent uint64
+}
+
+// gasEstimator computes the gas limits and the fees of the transactions. The move balance gas and the fees are computed
+// offline, from the network config, while the contract calls are simulated through the transaction cost endpoint
+type gasEstimator struct {
+	networkConfig       *data.NetworkConfig
+	costRequester       TransactionCostRequester
+	safetyMarginPercent uint64
+}
+
+// NewGasEstimator creates a new gas estimator instance
+func NewGasEstimator(args ArgsGasEstimator) (*gasEstimator, error) {
+	if args.NetworkConfig == nil {
+		return nil, ErrNilNetworkConfig
+	}
+	if check.IfNil(args.CostRequester) {
+		return nil, ErrNilTransactionCostRequester
+	}
+	if args.SafetyMarginPercent > maxGasSafetyMarginPercent {
+		return nil, fmt.Errorf("%w: %d%%, maximum is %d%%", ErrInvalidSafetyMargin, args.SafetyMarginPercent, maxGasSafetyMarginPercent)
+	}
+
+	return &gasEstimator{
+		networkConfig:       args.NetworkConfig,
+		costRequester:       args.CostRequester,
+		safetyMarginPercent: args.SafetyMarginPercent,
+	}, nil
+}
+
+// ComputeMoveBalanceGasLimit computes, offline, the gas limit needed for the transaction's move balance part: the
+// minimum gas limit, the data field cost and the extra gas of the guarded and of the relayed (v3) transactions
+func (estimator *gasEstimator) ComputeMoveBalanceGasLimit(tx *transaction.FrontendTransaction) uint64 {
+	return estimator.networkConfig.ComputeMoveBalanceGasLimit(tx)
+}
+
+// EstimateGasLimit returns the gas limit of the transaction. Transactions without a data field are move balance
+// transactions and are computed offline, all the others are simulated and the safety margin is added over the cost
+func (estimator *gasEstimator) EstimateGasLimit(ctx context.Context, tx *transaction.FrontendTransaction) (uint64, error) {
+	if tx == nil {
+		return 0, ErrNilTransaction
+	}
+
+	moveBalanceGasLimit := estimator.ComputeMoveBalanceGasLimit(tx)
+	if len(tx.Data) == 0 {
+		return moveBalanceGasLimit, nil
+	}
+
+	response, err := estimator.costRequester.RequestTransactionCost(ctx, tx)
+	if err != nil {
+		return 0, err
+	}
+	if len(response.RetMessage) > 0 {
+		return 0, fmt.Errorf("%w: %s", ErrTransactionCostFailed, response.RetMessage)
+	}
+
+	gasLimit := response.TxCost + response.TxCost*estimator.safetyMarginPercent/percentDivisor
+	if gasLimit < moveBalanceGasLimit {
+		gasLimit = moveBalanceGasLimit
+	}
+
+	return gasLimit, nil
+}
+
+// ApplyGasLimit estimates and sets the gas limit of the transaction
+func (estimator *gasEstimator) ApplyGasLimit(ctx context.Context, tx *transaction.FrontendTransaction) error {
+	gasLimit, err := estimator.EstimateGasLimit(ctx, tx)
+	if err != nil {
+		return err
+	}
+
+	tx.GasLimit = gasLimit
+
+	return nil
+}
+
+// ComputeFee computes the maximum fee of the transaction (as if all the provided gas is consumed), following the
+// protocol's formula: the move balance gas is charged at the full gas price while the rest of the gas is charged at
+// the processing gas price, adjusted by the gas price modifier. A not reported (zero) gas price modifier is treated as 1
+func (estimator *gasEstimator) ComputeFee(tx *transaction.FrontendTransaction) (*data.TransactionFeeBreakdown, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+
+	moveBalanceGas := estimator.ComputeMoveBalanceGasLimit(tx)
+	if tx.GasLimit < moveBalanceGas {
+		return nil, fmt.Errorf("%w: provided %d, move balance needs %d", data.ErrInsufficientGasLimit, tx.GasLimit, moveBalanceGas)
+	}
+
+	processingGas := tx.GasLimit - moveBalanceGas
+	processingGasPrice := estimator.computeProcessingGasPrice(tx.GasPrice)
+
+	moveBalanceFee := big.NewInt(0).Mul(big.NewInt(0).SetUint64(moveBalanceGas), big.NewInt(0).SetUint64(tx.GasPrice))
+	processingFee := big.NewInt(0).Mul(big.NewInt(0).SetUint64(processingGas), big.NewInt(0).SetUint64(processingGasPrice))
+
+	return &data.TransactionFeeBreakdown{
+		GasLimit:           tx.GasLimit,
+		GasPrice:           tx.GasPrice,
+		MoveBalanceGas:     moveBalanceGas,
+		ProcessingGas:      processingGas,
+		ProcessingGasPrice: processingGasPrice,
+		MoveBalanceFee:     moveBalanceFee,
+		ProcessingFee:      processingFee,
+		Fee:                big.NewInt(0).Add(moveBalanceFee, processingFee),
+	}, nil
+}
+
+func (estimator *gasEstimator) computeProcessingGasPrice(gasPrice uint64) uint64 {
+	gasPriceModifier := estimator.networkConfig.GasPriceModifier
+	if gasPriceModifier <= 0 {
+		return gasPrice
+	}
+
+	return uint64(float64(gasPrice) * gasPriceModifier)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (estimator *gasEstimator) IsInterfaceNil() bool {
+	return estimator == nil
+}
@@ -0,0 +1,247 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createGasEstimatorNetworkConfig() *data.NetworkConfig {
+	return &data.NetworkConfig{
+		MinGasLimit:            50000,
+		GasPerDataByte:         1500,
+		MinGasPrice:            1000000000,
+		ExtraGasLimitGuardedTx: 50000,
+		GasPriceModifier:       0.01,
+	}
+}
+
+func createMockArgsGasEstimator() ArgsGasEstimator {
+	return ArgsGasEstimator{
+		NetworkConfig:       createGasEstimatorNetworkConfig(),
+		CostRequester:       &testsCommon.ProxyStub{},
+		SafetyMarginPercent: DefaultGasSafetyMarginPercent,
+	}
+}
+
+func TestNewGasEstimator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil network config should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasEstimator()
+		args.NetworkConfig = nil
+		estimator, err := NewGasEstimator(args)
+		assert.True(t, check.IfNil(estimator))
+		assert.Equal(t, ErrNilNetworkConfig, err)
+	})
+	t.Run("nil cost requester should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasEstimator()
+		args.CostRequester = nil
+		estimator, err := NewGasEstimator(args)
+		assert.True(t, check.IfNil(estimator))
+		assert.Equal(t, ErrNilTransactionCostRequester, err)
+	})
+	t.Run("invalid safety margin should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasEstimator()
+		args.SafetyMarginPercent = maxGasSafetyMarginPercent + 1
+		estimator, err := NewGasEstimator(args)
+		assert.True(t, check.IfNil(estimator))
+		assert.ErrorIs(t, err, ErrInvalidSafetyMargin)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		estimator, err := NewGasEstimator(createMockArgsGasEstimator())
+		assert.False(t, check.IfNil(estimator))
+		assert.Nil(t, err)
+	})
+}
+
+func TestGasEstimator_ComputeMoveBalanceGasLimit(t *testing.T) {
+	t.Parallel()
+
+	estimator, _ := NewGasEstimator(createMockArgsGasEstimator())
+
+	tx := &transaction.FrontendTransaction{}
+	assert.Equal(t, uint64(50000), estimator.ComputeMoveBalanceGasLimit(tx))
+
+	tx.Data = []byte("hello")
+	assert.Equal(t, uint64(57500), estimator.ComputeMoveBalanceGasLimit(tx))
+
+	tx.Options = transaction.MaskGuardedTransaction
+	assert.Equal(t, uint64(107500), estimator.ComputeMoveBalanceGasLimit(tx))
+
+	tx.RelayerAddr = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	assert.Equal(t, uint64(157500), estimator.ComputeMoveBalanceGasLimit(tx))
+}
+
+func TestGasEstimator_EstimateGasLimit(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		estimator, _ := NewGasEstimator(createMockArgsGasEstimator())
+		gasLimit, err := estimator.EstimateGasLimit(context.Background(), nil)
+		assert.Zero(t, gasLimit)
+		assert.Equal(t, ErrNilTransaction, err)
+	})
+	t.Run("move balance should not request the cost", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasEstimator()
+		args.CostRequester = &testsCommon.ProxyStub{
+			RequestTransactionCostCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+				assert.Fail(t, "should have not been called")
+				return nil, nil
+			},
+		}
+		estimator, _ := NewGasEstimator(args)
+
+		tx := &transaction.FrontendTransaction{}
+		err := estimator.ApplyGasLimit(context.Background(), tx)
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(50000), tx.GasLimit)
+	})
+	t.Run("cost request error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		args := createMockArgsGasEstimator()
+		args.CostRequester = &testsCommon.ProxyStub{
+			RequestTransactionCostCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+				return nil, expectedErr
+			},
+		}
+		estimator, _ := NewGasEstimator(args)
+
+		tx := &transaction.FrontendTransaction{Data: []byte("add@01"), GasLimit: 1}
+		err := estimator.ApplyGasLimit(context.Background(), tx)
+		assert.Equal(t, expectedErr, err)
+		assert.Equal(t, uint64(1), tx.GasLimit)
+	})
+	t.Run("failed simulation should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasEstimator()
+		args.CostRequester = &testsCommon.ProxyStub{
+			RequestTransactionCostCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+				return &data.TxCostResponseData{RetMessage: "function not found"}, nil
+			},
+		}
+		estimator, _ := NewGasEstimator(args)
+
+		gasLimit, err := estimator.EstimateGasLimit(context.Background(), &transaction.FrontendTransaction{Data: []byte("add@01")})
+		assert.Zero(t, gasLimit)
+		assert.ErrorIs(t, err, ErrTransactionCostFailed)
+		assert.Contains(t, err.Error(), "function not found")
+	})
+	t.Run("contract call should add the safety margin", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasEstimator()
+		args.CostRequester = &testsCommon.ProxyStub{
+			RequestTransactionCostCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+				return &data.TxCostResponseData{TxCost: 1000000}, nil
+			},
+		}
+		estimator, _ := NewGasEstimator(args)
+
+		gasLimit, err := estimator.EstimateGasLimit(context.Background(), &transaction.FrontendTransaction{Data: []byte("add@01")})
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(1100000), gasLimit)
+	})
+	t.Run("contract call should not go below the move balance gas", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasEstimator()
+		args.CostRequester = &testsCommon.ProxyStub{
+			RequestTransactionCostCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+				return &data.TxCostResponseData{TxCost: 10}, nil
+			},
+		}
+		estimator, _ := NewGasEstimator(args)
+
+		gasLimit, err := estimator.EstimateGasLimit(context.Background(), &transaction.FrontendTransaction{Data: []byte("add@01")})
+		assert.Nil(t, err)
+		assert.Equal(t, uint64(59000), gasLimit)
+	})
+}
+
+func TestGasEstimator_ComputeFee(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		estimator, _ := NewGasEstimator(createMockArgsGasEstimator())
+		fee, err := estimator.ComputeFee(nil)
+		assert.Nil(t, fee)
+		assert.Equal(t, ErrNilTransaction, err)
+	})
+	t.Run("gas limit below move balance should error", func(t *testing.T) {
+		t.Parallel()
+
+		estimator, _ := NewGasEstimator(createMockArgsGasEstimator())
+		fee, err := estimator.ComputeFee(&transaction.FrontendTransaction{GasLimit: 49999})
+		assert.Nil(t, fee)
+		assert.ErrorIs(t, err, data.ErrInsufficientGasLimit)
+	})
+	t.Run("move balance transaction", func(t *testing.T) {
+		t.Parallel()
+
+		estimator, _ := NewGasEstimator(createMockArgsGasEstimator())
+		fee, err := estimator.ComputeFee(&transaction.FrontendTransaction{GasLimit: 50000, GasPrice: 1000000000})
+		require.Nil(t, err)
+		assert.Equal(t, big.NewInt(50000000000000), fee.Fee)
+		assert.Equal(t, big.NewInt(0), fee.ProcessingFee)
+	})
+	t.Run("contract call should apply the gas price modifier", func(t *testing.T) {
+		t.Parallel()
+
+		estimator, _ := NewGasEstimator(createMockArgsGasEstimator())
+		tx := &transaction.FrontendTransaction{
+			GasLimit: 200000,
+			GasPrice: 1000000000,
+			Data:     []byte("hello"),
+		}
+		fee, err := estimator.ComputeFee(tx)
+		require.Nil(t, err)
+
+		expectedFee := &data.TransactionFeeBreakdown{
+			GasLimit:           200000,
+			GasPrice:           1000000000,
+			MoveBalanceGas:     57500,
+			ProcessingGas:      142500,
+			ProcessingGasPrice: 10000000,
+			MoveBalanceFee:     big.NewInt(57500000000000),
+			ProcessingFee:      big.NewInt(1425000000000),
+			Fee:                big.NewInt(58925000000000),
+		}
+		assert.Equal(t, expectedFee, fee)
+	})
+	t.Run("not reported gas price modifier should charge the full gas price", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsGasEstimator()
+		args.NetworkConfig.GasPriceModifier = 0
+		estimator, _ := NewGasEstimator(args)
+		fee, err := estimator.ComputeFee(&transaction.FrontendTransaction{GasLimit: 60000, GasPrice: 10})
+		require.Nil(t, err)
+		assert.Equal(t, big.NewInt(600000), fee.Fee)
+	})
+}
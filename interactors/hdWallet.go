@@ -0,0 +1,195 @@
+package interactors
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// DefaultDiscoveryGapLimit is the number of consecutive unused addresses after which the discovery stops, as
+// recommended by BIP44
+const DefaultDiscoveryGapLimit = 20
+
+// DerivedAddress is an address derived from a mnemonic on the m/44'/508'/account'/0'/addressIndex' path. The private
+// key is not serialized, so the record can be persisted as it is
+type DerivedAddress struct {
+	Account      uint32 `json:"account"`
+	AddressIndex uint32 `json:"addressIndex"`
+	Address      string `json:"address"`
+	PrivateKey   []byte `json:"-"`
+}
+
+// DiscoveredAddress is a derived address that was found used on chain
+type DiscoveredAddress struct {
+	DerivedAddress
+	Nonce   uint64 `json:"nonce"`
+	Balance string `json:"balance"`
+}
+
+// DeriveAddresses derives count addresses of the provided account, starting with the startIndex address index
+func (w *wallet) DeriveAddresses(mnemonic data.Mnemonic, account uint32, startIndex uint32, count uint32) ([]*DerivedAddress, error) {
+	normalized, err := checkMnemonic([]byte(mnemonic))
+	if err != nil {
+		return nil, err
+	}
+
+	return w.DeriveAddressesFromSeed(w.CreateSeedFromMnemonic(data.Mnemonic(normalized)), account, startIndex, count)
+}
+
+// DeriveAddressesFromSeed derives count addresses of the provided account, starting with the startIndex address index.
+// The seed is the one returned by CreateSeedFromMnemonic
+func (w *wallet) DeriveAddressesFromSeed(seed []byte, account uint32, startIndex uint32, count uint32) ([]*DerivedAddress, error) {
+	if account >= hardened {
+		return nil, fmt.Errorf("%w: account %d", ErrInvalidDerivationRange, account)
+	}
+	endIndex := uint64(startIndex) + uint64(count)
+	if endIndex > uint64(hardened) {
+		return nil, fmt.Errorf("%w: address indexes [%d, %d)", ErrInvalidDerivationRange, startIndex, endIndex)
+	}
+
+	addresses := make([]*DerivedAddress, 0, count)
+	for index := startIndex; uint64(index) < endIndex; index++ {
+		privateKey := w.GetPrivateKeyFromSeed(seed, account, index)
+		address, err := w.GetAddressFromPrivateKey(privateKey)
+		if err != nil {
+			return nil, err
+		}
+		bech32Address, err := address.AddressAsBech32String()
+		if err != nil {
+			return nil, err
+		}
+
+		addresses = append(addresses, &DerivedAddress{
+			Account:      account,
+			AddressIndex: index,
+			Address:      bech32Address,
+			PrivateKey:   privateKey,
+		})
+	}
+
+	return addresses, nil
+}
+
+// ArgsAccountDiscoverer is the DTO used in the account discoverer constructor
+type ArgsAccountDiscoverer struct {
+	Proxy    Proxy
+	GapLimit uint32
+	// AddressConverter is optional, when not provided the default erd prefixed address converter is used
+	AddressConverter core.AddressConverter
+}
+
+// accountDiscoverer scans the addresses derived from a mnemonic and finds the ones that were used on chain: the
+// addresses with a non-zero nonce or a non-zero balance
+type accountDiscoverer struct {
+	proxy    Proxy
+	gapLimit uint32
+	wallet   *wallet
+}
+
+// NewAccountDiscoverer creates a new account discoverer instance
+func NewAccountDiscoverer(args ArgsAccountDiscoverer) (*accountDiscoverer, error) {
+	if check.IfNil(args.Proxy) {
+		return nil, ErrNilProxy
+	}
+	if args.GapLimit == 0 {
+		return nil, ErrInvalidGapLimit
+	}
+
+	return &accountDiscoverer{
+		proxy:    args.Proxy,
+		gapLimit: args.GapLimit,
+		wallet:   NewWalletWithAddressConverter(args.AddressConverter),
+	}, nil
+}
+
+// DiscoverAddresses returns the used addresses of the provided account. The scan stops after gap limit consecutive
+// unused addresses
+func (discoverer *accountDiscoverer) DiscoverAddresses(ctx context.Context, mnemonic data.Mnemonic, account uint32) ([]*DiscoveredAddress, error) {
+	normalized, err := checkMnemonic([]byte(mnemonic))
+	if err != nil {
+		return nil, err
+	}
+
+	return discoverer.discoverAddresses(ctx, discoverer.wallet.CreateSeedFromMnemonic(data.Mnemonic(normalized)), account)
+}
+
+// DiscoverAccounts scans the accounts in order and returns the used addresses of all of them. As in BIP44, the scan
+// stops at the first account that has no used addresses
+func (discoverer *accountDiscoverer) DiscoverAccounts(ctx context.Context, mnemonic data.Mnemonic) ([]*DiscoveredAddress, error) {
+	normalized, err := checkMnemonic([]byte(mnemonic))
+	if err != nil {
+		return nil, err
+	}
+
+	seed := discoverer.wallet.CreateSeedFromMnemonic(data.Mnemonic(normalized))
+	discovered := make([]*DiscoveredAddress, 0)
+	for account := uint32(0); account < hardened; account++ {
+		accountAddresses, errDiscover := discoverer.discoverAddresses(ctx, seed, account)
+		if errDiscover != nil {
+			return nil, errDiscover
+		}
+		if len(accountAddresses) == 0 {
+			break
+		}
+
+		discovered = append(discovered, accountAddresses...)
+	}
+
+	return discovered, nil
+}
+
+func (discoverer *accountDiscoverer) discoverAddresses(ctx context.Context, seed []byte, account uint32) ([]*DiscoveredAddress, error) {
+	discovered := make([]*DiscoveredAddress, 0)
+	unusedInARow := uint32(0)
+	for index := uint32(0); index < hardened && unusedInARow < discoverer.gapLimit; index++ {
+		derived, err := discoverer.wallet.DeriveAddressesFromSeed(seed, account, index, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		address, err := data.NewAddressFromBech32StringWithConverter(derived[0].Address, discoverer.wallet.addressConverter)
+		if err != nil {
+			return nil, err
+		}
+		onChainAccount, err := discoverer.proxy.GetAccount(ctx, address)
+		if err != nil {
+			return nil, fmt.Errorf("%w while fetching account %d, address index %d", err, account, index)
+		}
+
+		if !isAccountUsed(onChainAccount) {
+			unusedInARow++
+			continue
+		}
+
+		unusedInARow = 0
+		discovered = append(discovered, &DiscoveredAddress{
+			DerivedAddress: *derived[0],
+			Nonce:          onChainAccount.Nonce,
+			Balance:        onChainAccount.Balance,
+		})
+	}
+
+	return discovered, nil
+}
+
+func isAccountUsed(account *data.Account) bool {
+	if account == nil {
+		return false
+	}
+	if account.Nonce > 0 {
+		return true
+	}
+
+	balance, ok := big.NewInt(0).SetString(account.Balance, 10)
+
+	return ok && balance.Sign() != 0
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (discoverer *accountDiscoverer) IsInterfaceNil() bool {
+	return discoverer == nil
+}
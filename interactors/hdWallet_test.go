@@ -0,0 +1,184 @@
+package interactors
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestWallet_DeriveAddresses(t *testing.T) {
+	t.Parallel()
+
+	w := NewWallet()
+
+	t.Run("invalid mnemonic should error", func(t *testing.T) {
+		t.Parallel()
+
+		addresses, err := w.DeriveAddresses("not a mnemonic", 0, 0, 1)
+		assert.Nil(t, addresses)
+		assert.True(t, errors.Is(err, ErrInvalidMnemonic))
+	})
+	t.Run("hardened account should error", func(t *testing.T) {
+		t.Parallel()
+
+		addresses, err := w.DeriveAddresses(testKeystoreMnemonic, hardened, 0, 1)
+		assert.Nil(t, addresses)
+		assert.True(t, errors.Is(err, ErrInvalidDerivationRange))
+	})
+	t.Run("range outside the hardened indexes should error", func(t *testing.T) {
+		t.Parallel()
+
+		addresses, err := w.DeriveAddresses(testKeystoreMnemonic, 0, hardened-1, 2)
+		assert.Nil(t, addresses)
+		assert.True(t, errors.Is(err, ErrInvalidDerivationRange))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		addresses, err := w.DeriveAddresses(testKeystoreMnemonic, 1, 3, 4)
+		require.Nil(t, err)
+		require.Equal(t, 4, len(addresses))
+
+		for i, derived := range addresses {
+			index := uint32(3 + i)
+			assert.Equal(t, uint32(1), derived.Account)
+			assert.Equal(t, index, derived.AddressIndex)
+
+			expectedPrivateKey := w.GetPrivateKeyFromMnemonic(testKeystoreMnemonic, 1, index)
+			assert.Equal(t, expectedPrivateKey, derived.PrivateKey)
+			expectedAddress, _ := w.GetAddressFromPrivateKey(expectedPrivateKey)
+			expectedBech32, _ := expectedAddress.AddressAsBech32String()
+			assert.Equal(t, expectedBech32, derived.Address)
+		}
+
+		buff, err := json.Marshal(addresses[0])
+		require.Nil(t, err)
+		assert.Equal(t, `{"account":1,"addressIndex":3,"address":"`+addresses[0].Address+`"}`, string(buff))
+	})
+}
+
+func createDiscoveryProxy(t *testing.T, usedAddresses map[string]*data.Account) *testsCommon.ProxyStub {
+	return &testsCommon.ProxyStub{
+		GetAccountCalled: func(address core.AddressHandler) (*data.Account, error) {
+			bech32Address, err := address.AddressAsBech32String()
+			require.Nil(t, err)
+
+			account, found := usedAddresses[bech32Address]
+			if found {
+				return account, nil
+			}
+
+			return &data.Account{Address: bech32Address, Balance: "0"}, nil
+		},
+	}
+}
+
+func TestNewAccountDiscoverer(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		discoverer, err := NewAccountDiscoverer(ArgsAccountDiscoverer{GapLimit: DefaultDiscoveryGapLimit})
+		assert.True(t, check.IfNil(discoverer))
+		assert.Equal(t, ErrNilProxy, err)
+	})
+	t.Run("zero gap limit should error", func(t *testing.T) {
+		t.Parallel()
+
+		discoverer, err := NewAccountDiscoverer(ArgsAccountDiscoverer{Proxy: &testsCommon.ProxyStub{}})
+		assert.True(t, check.IfNil(discoverer))
+		assert.Equal(t, ErrInvalidGapLimit, err)
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		discoverer, err := NewAccountDiscoverer(ArgsAccountDiscoverer{Proxy: &testsCommon.ProxyStub{}, GapLimit: DefaultDiscoveryGapLimit})
+		assert.False(t, check.IfNil(discoverer))
+		assert.Nil(t, err)
+	})
+}
+
+func TestAccountDiscoverer_DiscoverAddresses(t *testing.T) {
+	t.Parallel()
+
+	w := NewWallet()
+	derived, err := w.DeriveAddresses(testKeystoreMnemonic, 0, 0, 10)
+	require.Nil(t, err)
+
+	t.Run("proxy error should error", func(t *testing.T) {
+		t.Parallel()
+
+		expectedErr := errors.New("expected error")
+		proxy := &testsCommon.ProxyStub{
+			GetAccountCalled: func(address core.AddressHandler) (*data.Account, error) {
+				return nil, expectedErr
+			},
+		}
+		discoverer, _ := NewAccountDiscoverer(ArgsAccountDiscoverer{Proxy: proxy, GapLimit: 3})
+
+		discovered, errDiscover := discoverer.DiscoverAddresses(context.Background(), testKeystoreMnemonic, 0)
+		assert.Nil(t, discovered)
+		assert.True(t, errors.Is(errDiscover, expectedErr))
+	})
+	t.Run("should stop after the gap limit", func(t *testing.T) {
+		t.Parallel()
+
+		usedAddresses := map[string]*data.Account{
+			derived[0].Address: {Nonce: 5, Balance: "0"},
+			derived[3].Address: {Nonce: 0, Balance: "1000"},
+			derived[8].Address: {Nonce: 1, Balance: "1"},
+		}
+		numCalls := 0
+		proxy := createDiscoveryProxy(t, usedAddresses)
+		getAccount := proxy.GetAccountCalled
+		proxy.GetAccountCalled = func(address core.AddressHandler) (*data.Account, error) {
+			numCalls++
+			return getAccount(address)
+		}
+		discoverer, _ := NewAccountDiscoverer(ArgsAccountDiscoverer{Proxy: proxy, GapLimit: 3})
+
+		discovered, errDiscover := discoverer.DiscoverAddresses(context.Background(), testKeystoreMnemonic, 0)
+		require.Nil(t, errDiscover)
+		require.Equal(t, 2, len(discovered))
+		assert.Equal(t, derived[0].Address, discovered[0].Address)
+		assert.Equal(t, uint64(5), discovered[0].Nonce)
+		assert.Equal(t, uint32(3), discovered[1].AddressIndex)
+		assert.Equal(t, "1000", discovered[1].Balance)
+		assert.Equal(t, 7, numCalls)
+	})
+}
+
+func TestAccountDiscoverer_DiscoverAccounts(t *testing.T) {
+	t.Parallel()
+
+	w := NewWallet()
+	account0, _ := w.DeriveAddresses(testKeystoreMnemonic, 0, 0, 5)
+	account1, _ := w.DeriveAddresses(testKeystoreMnemonic, 1, 0, 5)
+	account3, _ := w.DeriveAddresses(testKeystoreMnemonic, 3, 0, 5)
+
+	usedAddresses := map[string]*data.Account{
+		account0[1].Address: {Nonce: 1, Balance: "0"},
+		account1[0].Address: {Nonce: 2, Balance: "0"},
+		account1[2].Address: {Nonce: 3, Balance: "0"},
+		account3[0].Address: {Nonce: 4, Balance: "0"},
+	}
+	discoverer, _ := NewAccountDiscoverer(ArgsAccountDiscoverer{Proxy: createDiscoveryProxy(t, usedAddresses), GapLimit: 2})
+
+	discovered, err := discoverer.DiscoverAccounts(context.Background(), testKeystoreMnemonic)
+	require.Nil(t, err)
+	require.Equal(t, 3, len(discovered))
+	assert.Equal(t, account0[1].Address, discovered[0].Address)
+	assert.Equal(t, account1[0].Address, discovered[1].Address)
+	assert.Equal(t, account1[2].Address, discovered[2].Address)
+	assert.Equal(t, uint32(1), discovered[2].Account)
+	assert.Equal(t, uint32(2), discovered[2].AddressIndex)
+}
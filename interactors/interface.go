@@ -68,3 +68,16 @@ type TransactionNonceHandlerV3 interface {
 	Close()
 	IsInterfaceNil() bool
 }
+
+// TransactionCostRequester defines the component able to simulate a transaction and return its gas cost
+type TransactionCostRequester interface {
+	RequestTransactionCost(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error)
+	IsInterfaceNil() bool
+}
+
+// ShardCoordinator defines the component able to compute the shard of an address
+type ShardCoordinator interface {
+	ComputeShardId(address core.AddressHandler) (uint32, error)
+	NumberOfShards() uint32
+	IsInterfaceNil() bool
+}
@@ -0,0 +1,348 @@
+package interactors
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/pborman/uuid"
+	"golang.org/x/crypto/scrypt"
+)
+
+const (
+	// KeystoreKindSecretKey is the kind of the keystores holding a private key
+	KeystoreKindSecretKey = "secretKey"
+	// KeystoreKindMnemonic is the kind of the keystores holding a mnemonic
+	KeystoreKindMnemonic = "mnemonic"
+
+	keystoreCipher          = "aes-128-ctr"
+	keystoreSaltLen         = 32
+	keystoreFilePermissions = 0644
+	strongScryptN           = 1 << 17
+	encryptionKeyLen        = 16
+)
+
+// KeystoreKDFParams holds the scrypt parameters used to derive the encryption key from the password
+type KeystoreKDFParams struct {
+	N     int
+	R     int
+	P     int
+	DkLen int
+}
+
+// DefaultKeystoreKDFParams returns the scrypt parameters used by default (N=4096), compatible with all the wallets
+func DefaultKeystoreKDFParams() KeystoreKDFParams {
+	return KeystoreKDFParams{
+		N:     scryptN,
+		R:     scryptR,
+		P:     scryptP,
+		DkLen: scryptDKLen,
+	}
+}
+
+// StrongKeystoreKDFParams returns stronger scrypt parameters (N=131072), making the password brute-forcing much more
+// expensive. Deriving the key takes about 128MB of memory and may take a second
+func StrongKeystoreKDFParams() KeystoreKDFParams {
+	return KeystoreKDFParams{
+		N:     strongScryptN,
+		R:     scryptR,
+		P:     scryptP,
+		DkLen: scryptDKLen,
+	}
+}
+
+func (params KeystoreKDFParams) check() error {
+	isPowerOfTwo := params.N > 1 && params.N&(params.N-1) == 0
+	if !isPowerOfTwo {
+		return fmt.Errorf("%w: N should be a power of 2 greater than 1, got %d", ErrInvalidKDFParams, params.N)
+	}
+	if params.R <= 0 || params.P <= 0 {
+		return fmt.Errorf("%w: r and p should be positive, got r=%d, p=%d", ErrInvalidKDFParams, params.R, params.P)
+	}
+	if params.DkLen != scryptDKLen {
+		return fmt.Errorf("%w: dklen should be %d, got %d", ErrInvalidKDFParams, scryptDKLen, params.DkLen)
+	}
+
+	return nil
+}
+
+// KeystoreInfo holds the metadata of a keystore, available without knowing the password
+type KeystoreInfo struct {
+	Kind      string
+	Bech32    string
+	Id        string
+	Version   int
+	Cipher    string
+	KDF       string
+	KDFParams KeystoreKDFParams
+}
+
+// GetJsonFileInfo returns the metadata of the keystore, without decrypting it. The address is not available for the
+// mnemonic kind keystores
+func (w *wallet) GetJsonFileInfo(filename string) (*KeystoreInfo, error) {
+	key, err := readKeystoreFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	kind := key.Kind
+	if len(kind) == 0 {
+		kind = KeystoreKindSecretKey
+	}
+
+	return &KeystoreInfo{
+		Kind:      kind,
+		Bech32:    key.Bech32,
+		Id:        key.Id,
+		Version:   key.Version,
+		Cipher:    key.Crypto.Cipher,
+		KDF:       key.Crypto.KDF,
+		KDFParams: kdfParamsFromKeystore(key),
+	}, nil
+}
+
+// SaveMnemonicToJsonFile saves a password encrypted mnemonic to a mnemonic kind .json file
+func (w *wallet) SaveMnemonicToJsonFile(mnemonic data.Mnemonic, password string, filename string, params KeystoreKDFParams) error {
+	normalizedMnemonic, err := checkMnemonic([]byte(mnemonic))
+	if err != nil {
+		return err
+	}
+
+	key, err := encryptKeystore(normalizedMnemonic, password, params)
+	if err != nil {
+		return err
+	}
+	key.Kind = KeystoreKindMnemonic
+
+	return writeKeystoreFile(filename, key)
+}
+
+// LoadMnemonicFromJsonFile loads a password encrypted mnemonic from a mnemonic kind .json file
+func (w *wallet) LoadMnemonicFromJsonFile(filename string, password string) (data.Mnemonic, error) {
+	key, err := readKeystoreFile(filename)
+	if err != nil {
+		return "", err
+	}
+	if key.Kind != KeystoreKindMnemonic {
+		return "", fmt.Errorf("%w: expected %s, got %q", ErrUnknownKeystoreKind, KeystoreKindMnemonic, key.Kind)
+	}
+
+	decryptedData, err := decryptKeystore(key, password)
+	if err != nil {
+		return "", err
+	}
+
+	mnemonic, err := checkMnemonic(decryptedData)
+	if err != nil {
+		return "", err
+	}
+
+	return data.Mnemonic(mnemonic), nil
+}
+
+// ReEncryptJsonFile decrypts the keystore with the current password and saves it to the new file, encrypted with the
+// new password and KDF parameters. The kind and the address are preserved. The new file name can be the same as the
+// current one
+func (w *wallet) ReEncryptJsonFile(
+	filename string,
+	password string,
+	newFilename string,
+	newPassword string,
+	params KeystoreKDFParams,
+) error {
+	key, err := readKeystoreFile(filename)
+	if err != nil {
+		return err
+	}
+
+	decryptedData, err := decryptKeystore(key, password)
+	if err != nil {
+		return err
+	}
+
+	newKey, err := encryptKeystore(decryptedData, newPassword, params)
+	if err != nil {
+		return err
+	}
+	newKey.Kind = key.Kind
+	newKey.Address = key.Address
+	newKey.Bech32 = key.Bech32
+
+	return writeKeystoreFile(newFilename, newKey)
+}
+
+// checkMnemonic accepts the mnemonics in any of the supported languages
+func checkMnemonic(mnemonic []byte) ([]byte, error) {
+	normalized := normalizeMnemonicSpaces(string(mnemonic))
+	_, err := detectMnemonicLanguage(normalized)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(normalized), nil
+}
+
+func kdfParamsFromKeystore(key *encryptedKeyJSONV4) KeystoreKDFParams {
+	return KeystoreKDFParams{
+		N:     key.Crypto.KDFParams.N,
+		R:     key.Crypto.KDFParams.R,
+		P:     key.Crypto.KDFParams.P,
+		DkLen: key.Crypto.KDFParams.DkLen,
+	}
+}
+
+func readKeystoreFile(filename string) (*encryptedKeyJSONV4, error) {
+	buff, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &encryptedKeyJSONV4{}
+	err = json.Unmarshal(buff, key)
+	if err != nil {
+		return nil, err
+	}
+
+	return key, nil
+}
+
+func writeKeystoreFile(filename string, key *encryptedKeyJSONV4) error {
+	buff, err := json.Marshal(key)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, buff, keystoreFilePermissions)
+}
+
+func encryptKeystore(payload []byte, password string, params KeystoreKDFParams) (*encryptedKeyJSONV4, error) {
+	err := params.check()
+	if err != nil {
+		return nil, err
+	}
+
+	salt := make([]byte, keystoreSaltLen)
+	_, err = io.ReadFull(rand.Reader, salt)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := scrypt.Key([]byte(password), salt, params.N, params.R, params.P, params.DkLen)
+	if err != nil {
+		return nil, err
+	}
+
+	iv := make([]byte, aes.BlockSize)
+	_, err = io.ReadFull(rand.Reader, iv)
+	if err != nil {
+		return nil, err
+	}
+
+	aesBlock, err := aes.NewCipher(derivedKey[:encryptionKeyLen])
+	if err != nil {
+		return nil, err
+	}
+
+	stream := cipher.NewCTR(aesBlock, iv)
+	cipherText := make([]byte, len(payload))
+	stream.XORKeyStream(cipherText, payload)
+
+	mac, err := computeKeystoreMAC(derivedKey, cipherText)
+	if err != nil {
+		return nil, err
+	}
+
+	key := &encryptedKeyJSONV4{
+		Version: keystoreVersion,
+		Id:      uuid.New(),
+	}
+	key.Crypto.CipherParams.IV = hex.EncodeToString(iv)
+	key.Crypto.Cipher = keystoreCipher
+	key.Crypto.CipherText = hex.EncodeToString(cipherText)
+	key.Crypto.KDF = keyHeaderKDF
+	key.Crypto.MAC = hex.EncodeToString(mac)
+	key.Crypto.KDFParams.N = params.N
+	key.Crypto.KDFParams.R = params.R
+	key.Crypto.KDFParams.P = params.P
+	key.Crypto.KDFParams.DkLen = params.DkLen
+	key.Crypto.KDFParams.Salt = hex.EncodeToString(salt)
+
+	return key, nil
+}
+
+func decryptKeystore(key *encryptedKeyJSONV4, password string) ([]byte, error) {
+	if key.Crypto.KDF != keyHeaderKDF {
+		return nil, fmt.Errorf("%w: unsupported KDF %q", ErrInvalidKDFParams, key.Crypto.KDF)
+	}
+	err := kdfParamsFromKeystore(key).check()
+	if err != nil {
+		return nil, err
+	}
+
+	mac, err := hex.DecodeString(key.Crypto.MAC)
+	if err != nil {
+		return nil, err
+	}
+
+	iv, err := hex.DecodeString(key.Crypto.CipherParams.IV)
+	if err != nil {
+		return nil, err
+	}
+
+	cipherText, err := hex.DecodeString(key.Crypto.CipherText)
+	if err != nil {
+		return nil, err
+	}
+
+	salt, err := hex.DecodeString(key.Crypto.KDFParams.Salt)
+	if err != nil {
+		return nil, err
+	}
+
+	derivedKey, err := scrypt.Key([]byte(password), salt,
+		key.Crypto.KDFParams.N,
+		key.Crypto.KDFParams.R,
+		key.Crypto.KDFParams.P,
+		key.Crypto.KDFParams.DkLen)
+	if err != nil {
+		return nil, err
+	}
+
+	expectedMAC, err := computeKeystoreMAC(derivedKey, cipherText)
+	if err != nil {
+		return nil, err
+	}
+	if !bytes.Equal(expectedMAC, mac) {
+		return nil, ErrWrongPassword
+	}
+
+	aesBlock, err := aes.NewCipher(derivedKey[:encryptionKeyLen])
+	if err != nil {
+		return nil, err
+	}
+
+	stream := cipher.NewCTR(aesBlock, iv)
+	decryptedData := make([]byte, len(cipherText))
+	stream.XORKeyStream(decryptedData, cipherText)
+
+	return decryptedData, nil
+}
+
+func computeKeystoreMAC(derivedKey []byte, cipherText []byte) ([]byte, error) {
+	hash := hmac.New(sha256.New, derivedKey[encryptionKeyLen:scryptDKLen])
+	_, err := hash.Write(cipherText)
+	if err != nil {
+		return nil, err
+	}
+
+	return hash.Sum(nil), nil
+}
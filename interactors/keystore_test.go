@@ -0,0 +1,222 @@
+package interactors
+
+import (
+	"encoding/hex"
+	"errors"
+	"path"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const testKeystoreMnemonic = "moral volcano peasant pass circle pen over picture flat shop clap goat never lyrics gather prepare woman film husband gravity behind test tiger improve"
+
+func TestKeystoreKDFParams_check(t *testing.T) {
+	t.Parallel()
+
+	assert.Nil(t, DefaultKeystoreKDFParams().check())
+	assert.Nil(t, StrongKeystoreKDFParams().check())
+
+	params := DefaultKeystoreKDFParams()
+	params.N = 1000
+	assert.True(t, errors.Is(params.check(), ErrInvalidKDFParams))
+
+	params = DefaultKeystoreKDFParams()
+	params.P = 0
+	assert.True(t, errors.Is(params.check(), ErrInvalidKDFParams))
+
+	params = DefaultKeystoreKDFParams()
+	params.DkLen = 16
+	assert.True(t, errors.Is(params.check(), ErrInvalidKDFParams))
+}
+
+func TestWallet_SavePrivateKeyToJsonFileWithKDFParams(t *testing.T) {
+	t.Parallel()
+
+	w := NewWallet()
+	privKey, _ := hex.DecodeString("15cfe2140ee9821f706423036ba58d1e6ec13dbc4ebf206732ad40b5236af403")
+	password := "pAssword1~"
+
+	t.Run("invalid params should error", func(t *testing.T) {
+		t.Parallel()
+
+		params := DefaultKeystoreKDFParams()
+		params.R = 0
+		err := w.SavePrivateKeyToJsonFileWithKDFParams(privKey, password, path.Join(t.TempDir(), "key.json"), params)
+		assert.True(t, errors.Is(err, ErrInvalidKDFParams))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		params := DefaultKeystoreKDFParams()
+		params.N = 8192
+		filename := path.Join(t.TempDir(), "key.json")
+		err := w.SavePrivateKeyToJsonFileWithKDFParams(privKey, password, filename, params)
+		require.Nil(t, err)
+
+		info, err := w.GetJsonFileInfo(filename)
+		require.Nil(t, err)
+		assert.Equal(t, KeystoreKindSecretKey, info.Kind)
+		assert.Equal(t, "erd1h692scsz3um6e5qwzts4yjrewxqxwcwxzavl5n9q8sprussx8fqsu70jf5", info.Bech32)
+		assert.Equal(t, params, info.KDFParams)
+		assert.Equal(t, keystoreVersion, info.Version)
+		assert.Equal(t, keystoreCipher, info.Cipher)
+		assert.Equal(t, keyHeaderKDF, info.KDF)
+		assert.NotEmpty(t, info.Id)
+
+		recoveredSk, err := w.LoadPrivateKeyFromJsonFile(filename, password)
+		require.Nil(t, err)
+		assert.Equal(t, privKey, recoveredSk)
+	})
+}
+
+func TestWallet_GetJsonFileInfo(t *testing.T) {
+	t.Parallel()
+
+	w := NewWallet()
+
+	t.Run("missing file should error", func(t *testing.T) {
+		t.Parallel()
+
+		info, err := w.GetJsonFileInfo(path.Join(t.TempDir(), "missing.json"))
+		assert.Nil(t, info)
+		assert.NotNil(t, err)
+	})
+	t.Run("old format without kind should work", func(t *testing.T) {
+		t.Parallel()
+
+		info, err := w.GetJsonFileInfo("testdata/test.json")
+		require.Nil(t, err)
+		assert.Equal(t, KeystoreKindSecretKey, info.Kind)
+		assert.Equal(t, "erd1h692scsz3um6e5qwzts4yjrewxqxwcwxzavl5n9q8sprussx8fqsu70jf5", info.Bech32)
+		assert.Equal(t, DefaultKeystoreKDFParams(), info.KDFParams)
+	})
+	t.Run("mnemonic kind should work", func(t *testing.T) {
+		t.Parallel()
+
+		info, err := w.GetJsonFileInfo("testdata/testWithKind.json")
+		require.Nil(t, err)
+		assert.Equal(t, KeystoreKindMnemonic, info.Kind)
+		assert.Empty(t, info.Bech32)
+		assert.Equal(t, "5b448dbc-5c72-4d83-8038-938b1f8dff19", info.Id)
+	})
+}
+
+func TestWallet_SaveAndLoadMnemonicJsonFile(t *testing.T) {
+	t.Parallel()
+
+	w := NewWallet()
+
+	t.Run("invalid mnemonic should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := w.SaveMnemonicToJsonFile("not a mnemonic", "password", path.Join(t.TempDir(), "key.json"), DefaultKeystoreKDFParams())
+		assert.True(t, errors.Is(err, ErrInvalidMnemonic))
+	})
+	t.Run("secret key kind should not be loaded as mnemonic", func(t *testing.T) {
+		t.Parallel()
+
+		mnemonic, err := w.LoadMnemonicFromJsonFile("testdata/test.json", "pAssword1~")
+		assert.Empty(t, mnemonic)
+		assert.True(t, errors.Is(err, ErrUnknownKeystoreKind))
+	})
+	t.Run("wrong password should error", func(t *testing.T) {
+		t.Parallel()
+
+		mnemonic, err := w.LoadMnemonicFromJsonFile("testdata/testWithKind.json", "wrong")
+		assert.Empty(t, mnemonic)
+		assert.Equal(t, ErrWrongPassword, err)
+	})
+	t.Run("existing mnemonic keystore should work", func(t *testing.T) {
+		t.Parallel()
+
+		mnemonic, err := w.LoadMnemonicFromJsonFile("testdata/testWithKind.json", "password")
+		require.Nil(t, err)
+
+		privKey := w.GetPrivateKeyFromMnemonic(mnemonic, 0, 0)
+		assert.Equal(t, "413f42575f7f26fad3317a778771212fdb80245850981e48b58a4f25e344e8f9", hex.EncodeToString(privKey))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		filename := path.Join(t.TempDir(), "key.json")
+		err := w.SaveMnemonicToJsonFile(data.Mnemonic(" "+testKeystoreMnemonic+"\n"), "password", filename, DefaultKeystoreKDFParams())
+		require.Nil(t, err)
+
+		info, err := w.GetJsonFileInfo(filename)
+		require.Nil(t, err)
+		assert.Equal(t, KeystoreKindMnemonic, info.Kind)
+		assert.Empty(t, info.Bech32)
+
+		mnemonic, err := w.LoadMnemonicFromJsonFile(filename, "password")
+		require.Nil(t, err)
+		assert.Equal(t, data.Mnemonic(testKeystoreMnemonic), mnemonic)
+
+		privKey, err := w.LoadPrivateKeyFromJsonFile(filename, "password")
+		require.Nil(t, err)
+		assert.Equal(t, w.GetPrivateKeyFromMnemonic(testKeystoreMnemonic, 0, 0), privKey)
+	})
+	t.Run("non english mnemonic should work", func(t *testing.T) {
+		t.Parallel()
+
+		filename := path.Join(t.TempDir(), "key.json")
+		err := w.SaveMnemonicToJsonFile(testZeroEntropyJapaneseMnemonic, "password", filename, DefaultKeystoreKDFParams())
+		require.Nil(t, err)
+
+		privKey, err := w.LoadPrivateKeyFromJsonFile(filename, "password")
+		require.Nil(t, err)
+		options := MnemonicOptions{Language: MnemonicLanguageJapanese}
+		expectedPrivKey, _ := w.GetPrivateKeyFromMnemonicWithOptions(testZeroEntropyJapaneseMnemonic, options, 0, 0)
+		assert.Equal(t, expectedPrivKey, privKey)
+	})
+}
+
+func TestWallet_ReEncryptJsonFile(t *testing.T) {
+	t.Parallel()
+
+	w := NewWallet()
+
+	t.Run("wrong password should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := w.ReEncryptJsonFile("testdata/test.json", "wrong", path.Join(t.TempDir(), "key.json"), "new", DefaultKeystoreKDFParams())
+		assert.Equal(t, ErrWrongPassword, err)
+	})
+	t.Run("secret key kind should work", func(t *testing.T) {
+		t.Parallel()
+
+		params := DefaultKeystoreKDFParams()
+		params.N = 8192
+		filename := path.Join(t.TempDir(), "key.json")
+		err := w.ReEncryptJsonFile("testdata/test.json", "pAssword1~", filename, "new password", params)
+		require.Nil(t, err)
+
+		_, err = w.LoadPrivateKeyFromJsonFile(filename, "pAssword1~")
+		assert.Equal(t, ErrWrongPassword, err)
+
+		privKey, err := w.LoadPrivateKeyFromJsonFile(filename, "new password")
+		require.Nil(t, err)
+		assert.Equal(t, "15cfe2140ee9821f706423036ba58d1e6ec13dbc4ebf206732ad40b5236af403", hex.EncodeToString(privKey))
+
+		info, err := w.GetJsonFileInfo(filename)
+		require.Nil(t, err)
+		assert.Equal(t, params, info.KDFParams)
+		assert.Equal(t, "erd1h692scsz3um6e5qwzts4yjrewxqxwcwxzavl5n9q8sprussx8fqsu70jf5", info.Bech32)
+	})
+	t.Run("mnemonic kind in place should work", func(t *testing.T) {
+		t.Parallel()
+
+		filename := path.Join(t.TempDir(), "key.json")
+		err := w.SaveMnemonicToJsonFile(testKeystoreMnemonic, "password", filename, DefaultKeystoreKDFParams())
+		require.Nil(t, err)
+
+		err = w.ReEncryptJsonFile(filename, "password", filename, "new password", DefaultKeystoreKDFParams())
+		require.Nil(t, err)
+
+		mnemonic, err := w.LoadMnemonicFromJsonFile(filename, "new password")
+		require.Nil(t, err)
+		assert.Equal(t, data.Mnemonic(testKeystoreMnemonic), mnemonic)
+	})
+}
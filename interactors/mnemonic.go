@@ -0,0 +1,281 @@
+package interactors
+
+import (
+	"crypto/sha256"
+	"crypto/sha512"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/tyler-smith/go-bip39"
+	"github.com/tyler-smith/go-bip39/wordlists"
+	"golang.org/x/crypto/pbkdf2"
+	"golang.org/x/text/unicode/norm"
+)
+
+// MnemonicLanguage identifies the BIP39 wordlist of a mnemonic
+type MnemonicLanguage string
+
+const (
+	// MnemonicLanguageEnglish is the default, English, BIP39 wordlist
+	MnemonicLanguageEnglish MnemonicLanguage = "english"
+	// MnemonicLanguageJapanese is the Japanese BIP39 wordlist
+	MnemonicLanguageJapanese MnemonicLanguage = "japanese"
+	// MnemonicLanguageKorean is the Korean BIP39 wordlist
+	MnemonicLanguageKorean MnemonicLanguage = "korean"
+	// MnemonicLanguageSpanish is the Spanish BIP39 wordlist
+	MnemonicLanguageSpanish MnemonicLanguage = "spanish"
+	// MnemonicLanguageChineseSimplified is the simplified Chinese BIP39 wordlist
+	MnemonicLanguageChineseSimplified MnemonicLanguage = "chinese_simplified"
+	// MnemonicLanguageChineseTraditional is the traditional Chinese BIP39 wordlist
+	MnemonicLanguageChineseTraditional MnemonicLanguage = "chinese_traditional"
+	// MnemonicLanguageFrench is the French BIP39 wordlist
+	MnemonicLanguageFrench MnemonicLanguage = "french"
+	// MnemonicLanguageItalian is the Italian BIP39 wordlist
+	MnemonicLanguageItalian MnemonicLanguage = "italian"
+	// MnemonicLanguageCzech is the Czech BIP39 wordlist
+	MnemonicLanguageCzech MnemonicLanguage = "czech"
+
+	bitsPerWord        = 11
+	entropyBitsPerWord = 32
+	seedIterations     = 2048
+	seedLen            = 64
+	seedSaltPrefix     = "mnemonic"
+	japaneseSeparator  = "　"
+)
+
+// supportedLanguages is also the order in which the language of a mnemonic is detected, as a few words are found in
+// more than one wordlist
+var supportedLanguages = []MnemonicLanguage{
+	MnemonicLanguageEnglish,
+	MnemonicLanguageJapanese,
+	MnemonicLanguageKorean,
+	MnemonicLanguageSpanish,
+	MnemonicLanguageChineseSimplified,
+	MnemonicLanguageChineseTraditional,
+	MnemonicLanguageFrench,
+	MnemonicLanguageItalian,
+	MnemonicLanguageCzech,
+}
+
+var languageWordlists = map[MnemonicLanguage][]string{
+	MnemonicLanguageEnglish:            wordlists.English,
+	MnemonicLanguageJapanese:           wordlists.Japanese,
+	MnemonicLanguageKorean:             wordlists.Korean,
+	MnemonicLanguageSpanish:            wordlists.Spanish,
+	MnemonicLanguageChineseSimplified:  wordlists.ChineseSimplified,
+	MnemonicLanguageChineseTraditional: wordlists.ChineseTraditional,
+	MnemonicLanguageFrench:             wordlists.French,
+	MnemonicLanguageItalian:            wordlists.Italian,
+	MnemonicLanguageCzech:              wordlists.Czech,
+}
+
+var mutWordIndexes sync.Mutex
+var wordIndexes = make(map[MnemonicLanguage]map[string]int)
+
+// MnemonicOptions holds the optional BIP39 parameters of a mnemonic: its language and the passphrase (also known as
+// the 25th word) used when creating the seed
+type MnemonicOptions struct {
+	Language   MnemonicLanguage
+	Passphrase string
+}
+
+// DefaultMnemonicOptions returns the options used by the wallets that do not support BIP39 passphrases: English
+// wordlist and empty passphrase
+func DefaultMnemonicOptions() MnemonicOptions {
+	return MnemonicOptions{
+		Language:   MnemonicLanguageEnglish,
+		Passphrase: "",
+	}
+}
+
+// SupportedMnemonicLanguages returns the languages of the supported BIP39 wordlists
+func SupportedMnemonicLanguages() []MnemonicLanguage {
+	languages := make([]MnemonicLanguage, len(supportedLanguages))
+	copy(languages, supportedLanguages)
+
+	return languages
+}
+
+// GenerateMnemonicInLanguage will generate a new mnemonic value using the wordlist of the provided language
+func (w *wallet) GenerateMnemonicInLanguage(language MnemonicLanguage) (data.Mnemonic, error) {
+	wordlist, found := languageWordlists[language]
+	if !found {
+		return "", fmt.Errorf("%w: %s", ErrUnsupportedMnemonicLanguage, language)
+	}
+
+	entropy, err := bip39.NewEntropy(mnemonicBitSize)
+	if err != nil {
+		return "", err
+	}
+
+	separator := " "
+	if language == MnemonicLanguageJapanese {
+		separator = japaneseSeparator
+	}
+
+	return data.Mnemonic(strings.Join(entropyToWords(entropy, wordlist), separator)), nil
+}
+
+// ValidateMnemonic checks that all the words of the mnemonic are found in the wordlist of the provided language and
+// that the mnemonic checksum is correct
+func (w *wallet) ValidateMnemonic(mnemonic data.Mnemonic, language MnemonicLanguage) error {
+	return validateMnemonic(string(mnemonic), language)
+}
+
+// DetectMnemonicLanguage returns the language of a valid mnemonic
+func (w *wallet) DetectMnemonicLanguage(mnemonic data.Mnemonic) (MnemonicLanguage, error) {
+	return detectMnemonicLanguage(string(mnemonic))
+}
+
+// CreateSeedFromMnemonicWithOptions validates the mnemonic against the options' wordlist and creates its seed using
+// the options' passphrase
+func (w *wallet) CreateSeedFromMnemonicWithOptions(mnemonic data.Mnemonic, options MnemonicOptions) ([]byte, error) {
+	err := validateMnemonic(string(mnemonic), options.Language)
+	if err != nil {
+		return nil, err
+	}
+
+	return createSeed(normalizeMnemonicSpaces(string(mnemonic)), options.Passphrase), nil
+}
+
+// GetPrivateKeyFromMnemonicWithOptions generates a private key based on mnemonic, mnemonic options, account and
+// address index
+func (w *wallet) GetPrivateKeyFromMnemonicWithOptions(
+	mnemonic data.Mnemonic,
+	options MnemonicOptions,
+	account uint32,
+	addressIndex uint32,
+) ([]byte, error) {
+	seed, err := w.CreateSeedFromMnemonicWithOptions(mnemonic, options)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.GetPrivateKeyFromSeed(seed, account, addressIndex), nil
+}
+
+// createSeed computes the BIP39 seed: PBKDF2-HMAC-SHA512 over the NFKD normalized mnemonic, salted with the NFKD
+// normalized "mnemonic" + passphrase
+func createSeed(mnemonic string, passphrase string) []byte {
+	password := norm.NFKD.String(mnemonic)
+	salt := norm.NFKD.String(seedSaltPrefix + passphrase)
+
+	return pbkdf2.Key([]byte(password), []byte(salt), seedIterations, seedLen, sha512.New)
+}
+
+func normalizeMnemonicSpaces(mnemonic string) string {
+	return strings.Join(strings.Fields(mnemonic), " ")
+}
+
+// detectMnemonicLanguage returns the first language the mnemonic is valid in. For an invalid mnemonic, the checksum
+// error of a wordlist holding all the words is preferred over the error of the first (English) wordlist
+func detectMnemonicLanguage(mnemonic string) (MnemonicLanguage, error) {
+	var firstErr error
+	var checksumErr error
+	for _, language := range supportedLanguages {
+		err := validateMnemonic(mnemonic, language)
+		if err == nil {
+			return language, nil
+		}
+		if firstErr == nil {
+			firstErr = err
+		}
+		if checksumErr == nil && errors.Is(err, ErrMnemonicChecksum) {
+			checksumErr = err
+		}
+	}
+
+	if checksumErr != nil {
+		return "", checksumErr
+	}
+
+	return "", firstErr
+}
+
+func validateMnemonic(mnemonic string, language MnemonicLanguage) error {
+	indexes, err := getWordIndexes(language)
+	if err != nil {
+		return err
+	}
+
+	words := strings.Fields(mnemonic)
+	numWords := len(words)
+	if numWords < 12 || numWords > 24 || numWords%3 != 0 {
+		return fmt.Errorf("%w: %d words, expected 12, 15, 18, 21 or 24", ErrInvalidMnemonic, numWords)
+	}
+
+	totalBits := numWords * bitsPerWord
+	entropyBits := totalBits * entropyBitsPerWord / (entropyBitsPerWord + 1)
+	checksumBits := totalBits - entropyBits
+
+	buff := make([]byte, entropyBits/8+1)
+	for i, word := range words {
+		index, found := indexes[norm.NFKD.String(word)]
+		if !found {
+			return fmt.Errorf("%w: word %d (%q) is not in the %s wordlist", ErrInvalidMnemonic, i+1, word, language)
+		}
+
+		for bit := 0; bit < bitsPerWord; bit++ {
+			if index&(1<<(bitsPerWord-1-bit)) == 0 {
+				continue
+			}
+			position := i*bitsPerWord + bit
+			buff[position/8] |= 1 << (7 - position%8)
+		}
+	}
+
+	entropy := buff[:entropyBits/8]
+	hash := sha256.Sum256(entropy)
+	checksum := buff[entropyBits/8] >> (8 - checksumBits)
+	expectedChecksum := hash[0] >> (8 - checksumBits)
+	if checksum != expectedChecksum {
+		return fmt.Errorf("%w: %w", ErrInvalidMnemonic, ErrMnemonicChecksum)
+	}
+
+	return nil
+}
+
+func entropyToWords(entropy []byte, wordlist []string) []string {
+	hash := sha256.Sum256(entropy)
+	buff := append(append(make([]byte, 0, len(entropy)+1), entropy...), hash[0])
+
+	entropyBits := len(entropy) * 8
+	numWords := (entropyBits + entropyBits/entropyBitsPerWord) / bitsPerWord
+	words := make([]string, 0, numWords)
+	for i := 0; i < numWords; i++ {
+		index := 0
+		for bit := 0; bit < bitsPerWord; bit++ {
+			position := i*bitsPerWord + bit
+			index = index<<1 | int(buff[position/8]>>(7-position%8)&1)
+		}
+		words = append(words, wordlist[index])
+	}
+
+	return words
+}
+
+func getWordIndexes(language MnemonicLanguage) (map[string]int, error) {
+	mutWordIndexes.Lock()
+	defer mutWordIndexes.Unlock()
+
+	indexes, found := wordIndexes[language]
+	if found {
+		return indexes, nil
+	}
+
+	wordlist, found := languageWordlists[language]
+	if !found {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedMnemonicLanguage, language)
+	}
+
+	indexes = make(map[string]int, len(wordlist))
+	for index, word := range wordlist {
+		indexes[norm.NFKD.String(word)] = index
+	}
+	wordIndexes[language] = indexes
+
+	return indexes, nil
+}
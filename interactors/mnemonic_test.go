@@ -0,0 +1,193 @@
+package interactors
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/tyler-smith/go-bip39"
+	"github.com/tyler-smith/go-bip39/wordlists"
+)
+
+const (
+	testZeroEntropyMnemonic         = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+	testZeroEntropyJapaneseMnemonic = "あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あいこくしん　あおぞら"
+)
+
+func TestWallet_GenerateMnemonicInLanguage(t *testing.T) {
+	t.Parallel()
+
+	w := NewWallet()
+
+	t.Run("unsupported language should error", func(t *testing.T) {
+		t.Parallel()
+
+		mnemonic, err := w.GenerateMnemonicInLanguage("klingon")
+		assert.Empty(t, mnemonic)
+		assert.True(t, errors.Is(err, ErrUnsupportedMnemonicLanguage))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		for _, language := range SupportedMnemonicLanguages() {
+			mnemonic, err := w.GenerateMnemonicInLanguage(language)
+			require.Nil(t, err)
+			assert.Equal(t, 24, len(strings.Fields(string(mnemonic))))
+			assert.Nil(t, w.ValidateMnemonic(mnemonic, language))
+		}
+	})
+}
+
+func TestEntropyToWords_SameAsBip39(t *testing.T) {
+	t.Parallel()
+
+	for _, entropyLen := range []int{16, 20, 24, 28, 32} {
+		entropy := make([]byte, entropyLen)
+		_, _ = rand.Read(entropy)
+
+		expectedMnemonic, err := bip39.NewMnemonic(entropy)
+		require.Nil(t, err)
+		assert.Equal(t, expectedMnemonic, strings.Join(entropyToWords(entropy, languageWordlists[MnemonicLanguageEnglish]), " "))
+	}
+}
+
+func TestWallet_ValidateMnemonic(t *testing.T) {
+	t.Parallel()
+
+	w := NewWallet()
+
+	t.Run("unsupported language should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := w.ValidateMnemonic(testZeroEntropyMnemonic, "klingon")
+		assert.True(t, errors.Is(err, ErrUnsupportedMnemonicLanguage))
+	})
+	t.Run("invalid words count should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := w.ValidateMnemonic("abandon about", MnemonicLanguageEnglish)
+		assert.True(t, errors.Is(err, ErrInvalidMnemonic))
+		assert.Contains(t, err.Error(), "2 words")
+	})
+	t.Run("unknown word should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := w.ValidateMnemonic(data.Mnemonic(strings.Replace(testZeroEntropyMnemonic, "about", "aboot", 1)), MnemonicLanguageEnglish)
+		assert.True(t, errors.Is(err, ErrInvalidMnemonic))
+		assert.Contains(t, err.Error(), `word 12 ("aboot")`)
+	})
+	t.Run("wrong language should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := w.ValidateMnemonic(testZeroEntropyMnemonic, MnemonicLanguageJapanese)
+		assert.True(t, errors.Is(err, ErrInvalidMnemonic))
+	})
+	t.Run("wrong checksum should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := w.ValidateMnemonic(data.Mnemonic(strings.Replace(testZeroEntropyMnemonic, "about", "abandon", 1)), MnemonicLanguageEnglish)
+		assert.True(t, errors.Is(err, ErrInvalidMnemonic))
+		assert.True(t, errors.Is(err, ErrMnemonicChecksum))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		assert.Nil(t, w.ValidateMnemonic(testZeroEntropyMnemonic, MnemonicLanguageEnglish))
+		assert.Nil(t, w.ValidateMnemonic(testKeystoreMnemonic, MnemonicLanguageEnglish))
+		assert.Nil(t, w.ValidateMnemonic(testZeroEntropyJapaneseMnemonic, MnemonicLanguageJapanese))
+	})
+}
+
+func TestWallet_DetectMnemonicLanguage(t *testing.T) {
+	t.Parallel()
+
+	w := NewWallet()
+
+	language, err := w.DetectMnemonicLanguage(testZeroEntropyJapaneseMnemonic)
+	assert.Nil(t, err)
+	assert.Equal(t, MnemonicLanguageJapanese, language)
+
+	language, err = w.DetectMnemonicLanguage(testKeystoreMnemonic)
+	assert.Nil(t, err)
+	assert.Equal(t, MnemonicLanguageEnglish, language)
+
+	language, err = w.DetectMnemonicLanguage("not a mnemonic")
+	assert.Empty(t, language)
+	assert.True(t, errors.Is(err, ErrInvalidMnemonic))
+
+	words := make([]string, 12)
+	for i := range words {
+		words[i] = wordlists.Japanese[0]
+	}
+	language, err = w.DetectMnemonicLanguage(data.Mnemonic(strings.Join(words, japaneseSeparator)))
+	assert.Empty(t, language)
+	assert.True(t, errors.Is(err, ErrMnemonicChecksum))
+}
+
+func TestWallet_CreateSeedFromMnemonicWithOptions(t *testing.T) {
+	t.Parallel()
+
+	w := NewWallet()
+
+	t.Run("invalid mnemonic should error", func(t *testing.T) {
+		t.Parallel()
+
+		seed, err := w.CreateSeedFromMnemonicWithOptions("not a mnemonic", DefaultMnemonicOptions())
+		assert.Nil(t, seed)
+		assert.True(t, errors.Is(err, ErrInvalidMnemonic))
+	})
+	t.Run("default options should return the same seed", func(t *testing.T) {
+		t.Parallel()
+
+		seed, err := w.CreateSeedFromMnemonicWithOptions(testKeystoreMnemonic, DefaultMnemonicOptions())
+		require.Nil(t, err)
+		assert.Equal(t, w.CreateSeedFromMnemonic(testKeystoreMnemonic), seed)
+	})
+	t.Run("english passphrase should work", func(t *testing.T) {
+		t.Parallel()
+
+		options := MnemonicOptions{
+			Language:   MnemonicLanguageEnglish,
+			Passphrase: "TREZOR",
+		}
+		seed, err := w.CreateSeedFromMnemonicWithOptions(testZeroEntropyMnemonic, options)
+		require.Nil(t, err)
+		assert.Equal(t, "c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04", hex.EncodeToString(seed))
+	})
+	t.Run("japanese passphrase should work", func(t *testing.T) {
+		t.Parallel()
+
+		options := MnemonicOptions{
+			Language:   MnemonicLanguageJapanese,
+			Passphrase: "㍍ガバヴァぱばぐゞちぢ十人十色",
+		}
+		seed, err := w.CreateSeedFromMnemonicWithOptions(testZeroEntropyJapaneseMnemonic, options)
+		require.Nil(t, err)
+		assert.Equal(t, "a262d6fb6122ecf45be09c50492b31f92e9beb7d9a845987a02cefda57a15f9c467a17872029a9e92299b5cbdf306e3a0ee620245cbd508959b6cb7ca637bd55", hex.EncodeToString(seed))
+	})
+}
+
+func TestWallet_GetPrivateKeyFromMnemonicWithOptions(t *testing.T) {
+	t.Parallel()
+
+	w := NewWallet()
+
+	privateKey, err := w.GetPrivateKeyFromMnemonicWithOptions(testKeystoreMnemonic, DefaultMnemonicOptions(), 0, 1)
+	require.Nil(t, err)
+	assert.Equal(t, w.GetPrivateKeyFromMnemonic(testKeystoreMnemonic, 0, 1), privateKey)
+
+	options := DefaultMnemonicOptions()
+	options.Passphrase = "25th word"
+	privateKeyWithPassphrase, err := w.GetPrivateKeyFromMnemonicWithOptions(testKeystoreMnemonic, options, 0, 1)
+	require.Nil(t, err)
+	assert.NotEqual(t, privateKey, privateKeyWithPassphrase)
+
+	privateKey, err = w.GetPrivateKeyFromMnemonicWithOptions(data.Mnemonic("not a mnemonic"), DefaultMnemonicOptions(), 0, 0)
+	assert.Nil(t, privateKey)
+	assert.True(t, errors.Is(err, ErrInvalidMnemonic))
+}
@@ -34,12 +34,25 @@ type nonceTransactionsHandlerV1 struct {
 	checkForDuplicates bool
 	cancelFunc         func()
 	intervalToResend   time.Duration
+	addressConverter   core.AddressConverter
 }
 
 // NewNonceTransactionHandlerV1 will create a new instance of the nonceTransactionsHandlerV1. It requires a Proxy implementation
 // and an interval at which the transactions sent are rechecked and eventually, resent.
 // checkForDuplicates set as true will prevent sending a transaction with the same receiver, value and data.
 func NewNonceTransactionHandlerV1(proxy interactors.Proxy, intervalToResend time.Duration, checkForDuplicates bool) (*nonceTransactionsHandlerV1, error) {
+	return NewNonceTransactionHandlerV1WithAddressConverter(proxy, intervalToResend, checkForDuplicates, core.AddressPublicKeyConverter)
+}
+
+// NewNonceTransactionHandlerV1WithAddressConverter will create a new instance of the nonceTransactionsHandlerV1 that
+// decodes the transactions' senders using the provided address converter, for the chains that do not use the default
+// address prefix. A nil converter means the default one
+func NewNonceTransactionHandlerV1WithAddressConverter(
+	proxy interactors.Proxy,
+	intervalToResend time.Duration,
+	checkForDuplicates bool,
+	addressConverter core.AddressConverter,
+) (*nonceTransactionsHandlerV1, error) {
 	if check.IfNil(proxy) {
 		return nil, interactors.ErrNilProxy
 	}
@@ -52,6 +65,7 @@ func NewNonceTransactionHandlerV1(proxy interactors.Proxy, intervalToResend time
 		handlers:           make(map[string]*addressNonceHandler),
 		intervalToResend:   intervalToResend,
 		checkForDuplicates: checkForDuplicates,
+		addressConverter:   core.AddressConverterOrDefault(addressConverter),
 	}
 
 	ctx, cancelFunc := context.WithCancel(context.Background())
@@ -92,7 +106,7 @@ func (nth *nonceTransactionsHandlerV1) SendTransaction(ctx context.Context, tx *
 	}
 
 	addrAsBech32 := tx.Sender
-	addressHandler, err := data.NewAddressFromBech32String(addrAsBech32)
+	addressHandler, err := data.NewAddressFromBech32StringWithConverter(addrAsBech32, nth.addressConverter)
 	if err != nil {
 		return "", fmt.Errorf("%w while creating address handler for string %s", err, addrAsBech32)
 	}
@@ -23,6 +23,8 @@ var log = logger.GetOrCreate("mx-sdk-go/interactors/nonceHandlerV2")
 type ArgsNonceTransactionsHandlerV2 struct {
 	Proxy            interactors.Proxy
 	IntervalToResend time.Duration
+	// AddressConverter is optional, when not provided the default erd prefixed address converter is used
+	AddressConverter core.AddressConverter
 }
 
 // nonceTransactionsHandlerV2 is the handler used for an unlimited number of addresses.
@@ -39,6 +41,7 @@ type nonceTransactionsHandlerV2 struct {
 	handlers         map[string]interactors.AddressNonceHandler
 	cancelFunc       func()
 	intervalToResend time.Duration
+	addressConverter core.AddressConverter
 }
 
 // NewNonceTransactionHandlerV2 will create a new instance of the nonceTransactionsHandlerV2. It requires a Proxy implementation
@@ -55,6 +58,7 @@ func NewNonceTransactionHandlerV2(args ArgsNonceTransactionsHandlerV2) (*nonceTr
 		proxy:            args.Proxy,
 		handlers:         make(map[string]interactors.AddressNonceHandler),
 		intervalToResend: args.IntervalToResend,
+		addressConverter: core.AddressConverterOrDefault(args.AddressConverter),
 	}
 
 	ctx, cancelFunc := context.WithCancel(context.Background())
@@ -131,7 +135,7 @@ func (nth *nonceTransactionsHandlerV2) SendTransaction(ctx context.Context, tx *
 	txCopy := *tx
 
 	addrAsBech32 := txCopy.Sender
-	address, err := data.NewAddressFromBech32String(addrAsBech32)
+	address, err := data.NewAddressFromBech32StringWithConverter(addrAsBech32, nth.addressConverter)
 	if err != nil {
 		return "", fmt.Errorf("%w while creating address handler for string %s", err, addrAsBech32)
 	}
@@ -288,6 +288,37 @@ func TestNonceTransactionsHandlerV2_SendTransactionErrors(t *testing.T) {
 	require.Equal(t, "", hash)
 }
 
+func TestNonceTransactionsHandlerV2_SendTransactionWithAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	converter, err := core.NewAddressPublicKeyConverter("sov")
+	require.Nil(t, err)
+
+	tx := createMockTransactions(testAddress, 1, 664)[0]
+	tx.Sender, err = converter.Encode(testAddress.AddressBytes())
+	require.Nil(t, err)
+
+	args := createMockArgsNonceTransactionsHandlerV2()
+	args.Proxy = &testsCommon.ProxyStub{
+		SendTransactionCalled: func(tx *transaction.FrontendTransaction) (string, error) {
+			return "hash", nil
+		},
+	}
+
+	nth, _ := NewNonceTransactionHandlerV2(args)
+	hash, err := nth.SendTransaction(context.Background(), tx)
+	assert.NotNil(t, err)
+	assert.Equal(t, "", hash)
+	require.Nil(t, nth.Close())
+
+	args.AddressConverter = converter
+	nth, _ = NewNonceTransactionHandlerV2(args)
+	hash, err = nth.SendTransaction(context.Background(), tx)
+	assert.Nil(t, err)
+	assert.Equal(t, "hash", hash)
+	require.Nil(t, nth.Close())
+}
+
 func createMockTransactions(addr core.AddressHandler, numTxs int, startNonce uint64) []*transaction.FrontendTransaction {
 	txs := make([]*transaction.FrontendTransaction, 0, numTxs)
 	addrAsBech32String, _ := addr.AddressAsBech32String()
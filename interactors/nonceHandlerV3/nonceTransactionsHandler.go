@@ -24,6 +24,8 @@ var log = logger.GetOrCreate("mx-sdk-go/interactors/nonceHandlerV3")
 type ArgsNonceTransactionsHandlerV3 struct {
 	Proxy          interactors.Proxy
 	IntervalToSend time.Duration
+	// AddressConverter is optional, when not provided the default erd prefixed address converter is used
+	AddressConverter core.AddressConverter
 }
 
 // nonceTransactionsHandlerV3 is the handler used for an unlimited number of addresses.
@@ -35,10 +37,11 @@ type ArgsNonceTransactionsHandlerV3 struct {
 // nonceTransactionsHandlerV3 should be terminated and collected by the GC.
 // This struct is concurrent safe.
 type nonceTransactionsHandlerV3 struct {
-	proxy          interactors.Proxy
-	mutHandlers    sync.RWMutex
-	handlers       map[string]interactors.AddressNonceHandlerV3
-	intervalToSend time.Duration
+	proxy            interactors.Proxy
+	mutHandlers      sync.RWMutex
+	handlers         map[string]interactors.AddressNonceHandlerV3
+	intervalToSend   time.Duration
+	addressConverter core.AddressConverter
 }
 
 // NewNonceTransactionHandlerV3 will create a new instance of the nonceTransactionsHandlerV3. It requires a Proxy implementation
@@ -52,9 +55,10 @@ func NewNonceTransactionHandlerV3(args ArgsNonceTransactionsHandlerV3) (*nonceTr
 	}
 
 	nth := &nonceTransactionsHandlerV3{
-		proxy:          args.Proxy,
-		handlers:       make(map[string]interactors.AddressNonceHandlerV3),
-		intervalToSend: args.IntervalToSend,
+		proxy:            args.Proxy,
+		handlers:         make(map[string]interactors.AddressNonceHandlerV3),
+		intervalToSend:   args.IntervalToSend,
+		addressConverter: core.AddressConverterOrDefault(args.AddressConverter),
 	}
 
 	return nth, nil
@@ -69,7 +73,7 @@ func (nth *nonceTransactionsHandlerV3) ApplyNonceAndGasPrice(ctx context.Context
 	mapAddressTransactions := nth.filterTransactionsBySenderAddress(tx)
 
 	for addressRawString, transactions := range mapAddressTransactions {
-		address, err := data.NewAddressFromBech32String(addressRawString)
+		address, err := data.NewAddressFromBech32StringWithConverter(addressRawString, nth.addressConverter)
 		if err != nil {
 			return err
 		}
@@ -153,7 +157,7 @@ func (nth *nonceTransactionsHandlerV3) SendTransactions(ctx context.Context, txs
 		txCopy := *tx
 
 		addrAsBech32 := txCopy.Sender
-		address, err := data.NewAddressFromBech32String(addrAsBech32)
+		address, err := data.NewAddressFromBech32StringWithConverter(addrAsBech32, nth.addressConverter)
 		if err != nil {
 			return nil, fmt.Errorf("%w while creating address handler for string %s", err, addrAsBech32)
 		}
@@ -0,0 +1,120 @@
+package interactors
+
+import (
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const pemLabelPrefix = "PRIVATE KEY for "
+
+// LoadAllPrivateKeysFromPemFile loads all the private keys from a .pem file, in the order they appear in the file
+func (w *wallet) LoadAllPrivateKeysFromPemFile(filename string) ([]*data.PemKey, error) {
+	buff, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return w.LoadAllPrivateKeysFromPemData(buff)
+}
+
+// LoadAllPrivateKeysFromPemData returns all the private keys from the pem data, in the order they appear in the data
+func (w *wallet) LoadAllPrivateKeysFromPemData(buff []byte) ([]*data.PemKey, error) {
+	keys := make([]*data.PemKey, 0)
+	for {
+		blk, rest := pem.Decode(buff)
+		if blk == nil {
+			break
+		}
+		buff = rest
+
+		privateKey, err := privateKeyFromPemBlock(blk)
+		if err != nil {
+			return nil, fmt.Errorf("%w: key %d, %s", ErrInvalidPemFile, len(keys), err.Error())
+		}
+
+		address, err := w.GetAddressFromPrivateKey(privateKey)
+		if err != nil {
+			return nil, fmt.Errorf("%w: key %d, %s", ErrInvalidPemFile, len(keys), err.Error())
+		}
+
+		keys = append(keys, &data.PemKey{
+			Label:      strings.TrimPrefix(blk.Type, pemLabelPrefix),
+			PrivateKey: privateKey,
+			Address:    address,
+		})
+	}
+
+	if len(keys) == 0 {
+		return nil, ErrInvalidPemFile
+	}
+
+	return keys, nil
+}
+
+// LoadPrivateKeyFromPemFileByIndex loads the private key found at the provided index in a multi-key .pem file
+func (w *wallet) LoadPrivateKeyFromPemFileByIndex(filename string, index int) ([]byte, error) {
+	keys, err := w.LoadAllPrivateKeysFromPemFile(filename)
+	if err != nil {
+		return nil, err
+	}
+	if index < 0 || index >= len(keys) {
+		return nil, fmt.Errorf("%w: index %d, the file holds %d keys", ErrPemKeyNotFound, index, len(keys))
+	}
+
+	return keys[index].PrivateKey, nil
+}
+
+// LoadPrivateKeyFromPemFileByAddress loads the private key of the provided bech32 address from a multi-key .pem file
+func (w *wallet) LoadPrivateKeyFromPemFileByAddress(filename string, bech32Address string) ([]byte, error) {
+	keys, err := w.LoadAllPrivateKeysFromPemFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		keyAddress, errConvert := key.Address.AddressAsBech32String()
+		if errConvert != nil {
+			return nil, errConvert
+		}
+		if keyAddress == bech32Address {
+			return key.PrivateKey, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: address %s", ErrPemKeyNotFound, bech32Address)
+}
+
+// AppendPrivateKeyToPemFile appends the private key at the end of the .pem file. The file is created if it does not exist
+func (w *wallet) AppendPrivateKeyToPemFile(privateKey []byte, filename string) error {
+	blk, err := w.createPemBlock(privateKey)
+	if err != nil {
+		return err
+	}
+
+	existingContent, err := os.ReadFile(filename)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+
+	file, err := os.OpenFile(filename, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	missingNewLine := len(existingContent) > 0 && existingContent[len(existingContent)-1] != '\n'
+	if missingNewLine {
+		_, err = file.Write([]byte("\n"))
+		if err != nil {
+			return err
+		}
+	}
+
+	return pem.Encode(file, blk)
+}
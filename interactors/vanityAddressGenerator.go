@@ -0,0 +1,272 @@
+package interactors
+
+import (
+	"context"
+	"crypto/rand"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+const (
+	bech32Charset   = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	bech32Separator = "1"
+)
+
+// VanityAddress is an address found by the vanity address generator
+type VanityAddress struct {
+	Address    string
+	ShardID    uint32
+	PrivateKey []byte `json:"-"`
+}
+
+// VanityGeneratorStats holds the progress of the vanity address generator
+type VanityGeneratorStats struct {
+	Attempts uint64
+	Found    int
+	Elapsed  time.Duration
+}
+
+// AttemptsPerSecond returns the generator's throughput
+func (stats VanityGeneratorStats) AttemptsPerSecond() float64 {
+	if stats.Elapsed <= 0 {
+		return 0
+	}
+
+	return float64(stats.Attempts) / stats.Elapsed.Seconds()
+}
+
+// ArgsVanityAddressGenerator is the DTO used in the vanity address generator constructor
+type ArgsVanityAddressGenerator struct {
+	ShardCoordinator ShardCoordinator
+	// TargetShard should be lower than the number of shards, the metachain can not hold user addresses
+	TargetShard uint32
+	// Prefix and Suffix are optional and are matched against the bech32 data part (the part after "erd1" for the
+	// default address converter)
+	Prefix string
+	Suffix string
+	// NumWorkers defaults to the number of CPUs
+	NumWorkers int
+	// StatsHandler is optional, when provided it is called every StatsInterval and when the generation ends
+	StatsHandler  func(stats VanityGeneratorStats)
+	StatsInterval time.Duration
+	// AddressConverter is optional, when not provided the default erd prefixed address converter is used
+	AddressConverter core.AddressConverter
+}
+
+type vanityAddressGenerator struct {
+	wallet           *wallet
+	shardCoordinator ShardCoordinator
+	targetShard      uint32
+	prefix           string
+	suffix           string
+	numWorkers       int
+	statsHandler     func(stats VanityGeneratorStats)
+	statsInterval    time.Duration
+}
+
+// NewVanityAddressGenerator creates a new generator able to grind ed25519 keys until their addresses fall in the
+// target shard and match the optional prefix and suffix
+func NewVanityAddressGenerator(args ArgsVanityAddressGenerator) (*vanityAddressGenerator, error) {
+	if check.IfNil(args.ShardCoordinator) {
+		return nil, ErrNilShardCoordinator
+	}
+	if args.TargetShard >= args.ShardCoordinator.NumberOfShards() {
+		return nil, fmt.Errorf("%w: %d, the number of shards is %d",
+			ErrInvalidTargetShard, args.TargetShard, args.ShardCoordinator.NumberOfShards())
+	}
+	prefix := strings.ToLower(args.Prefix)
+	suffix := strings.ToLower(args.Suffix)
+	err := checkVanityPattern(prefix + suffix)
+	if err != nil {
+		return nil, err
+	}
+
+	numWorkers := args.NumWorkers
+	if numWorkers < 1 {
+		numWorkers = runtime.NumCPU()
+	}
+
+	return &vanityAddressGenerator{
+		wallet:           NewWalletWithAddressConverter(args.AddressConverter),
+		shardCoordinator: args.ShardCoordinator,
+		targetShard:      args.TargetShard,
+		prefix:           prefix,
+		suffix:           suffix,
+		numWorkers:       numWorkers,
+		statsHandler:     args.StatsHandler,
+		statsInterval:    args.StatsInterval,
+	}, nil
+}
+
+func checkVanityPattern(pattern string) error {
+	for _, char := range pattern {
+		if !strings.ContainsRune(bech32Charset, char) {
+			return fmt.Errorf("%w: character %q is not in the bech32 charset %s", ErrInvalidVanityPattern, char, bech32Charset)
+		}
+	}
+
+	return nil
+}
+
+// Generate grinds keys on all the workers until numAddresses matching addresses are found. If the context is done
+// before, the addresses found so far are returned together with the context's error
+func (generator *vanityAddressGenerator) Generate(ctx context.Context, numAddresses int) ([]*VanityAddress, error) {
+	if numAddresses < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidNumberOfAddresses, numAddresses)
+	}
+
+	workersCtx, cancel := context.WithCancel(ctx)
+	results := make(chan *VanityAddress, generator.numWorkers)
+	attempts := &atomic.Uint64{}
+	wg := &sync.WaitGroup{}
+	wg.Add(generator.numWorkers)
+	for i := 0; i < generator.numWorkers; i++ {
+		go func() {
+			defer wg.Done()
+			generator.grind(workersCtx, attempts, results)
+		}()
+	}
+	defer func() {
+		cancel()
+		wg.Wait()
+	}()
+
+	var statsChan <-chan time.Time
+	if generator.statsHandler != nil && generator.statsInterval > 0 {
+		ticker := time.NewTicker(generator.statsInterval)
+		defer ticker.Stop()
+		statsChan = ticker.C
+	}
+
+	startTime := time.Now()
+	addresses := make([]*VanityAddress, 0, numAddresses)
+	for len(addresses) < numAddresses {
+		select {
+		case <-ctx.Done():
+			generator.reportStats(attempts.Load(), len(addresses), startTime)
+			return addresses, ctx.Err()
+		case address := <-results:
+			addresses = append(addresses, address)
+		case <-statsChan:
+			generator.reportStats(attempts.Load(), len(addresses), startTime)
+		}
+	}
+
+	generator.reportStats(attempts.Load(), len(addresses), startTime)
+
+	return addresses, nil
+}
+
+func (generator *vanityAddressGenerator) grind(ctx context.Context, attempts *atomic.Uint64, results chan<- *VanityAddress) {
+	for ctx.Err() == nil {
+		address, err := generator.tryNewKey()
+		attempts.Add(1)
+		if err != nil {
+			log.Debug("vanityAddressGenerator.grind", "error", err)
+			continue
+		}
+		if address == nil {
+			continue
+		}
+
+		select {
+		case results <- address:
+		case <-ctx.Done():
+			return
+		}
+	}
+}
+
+// tryNewKey returns nil if the new key's address does not match
+func (generator *vanityAddressGenerator) tryNewKey() (*VanityAddress, error) {
+	privateKey := make([]byte, addressLen)
+	_, err := rand.Read(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	address, err := generator.wallet.GetAddressFromPrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	shardID, err := generator.shardCoordinator.ComputeShardId(address)
+	if err != nil {
+		return nil, err
+	}
+	if shardID != generator.targetShard {
+		return nil, nil
+	}
+
+	bech32Address, err := address.AddressAsBech32String()
+	if err != nil {
+		return nil, err
+	}
+
+	dataPart := bech32Address[strings.LastIndex(bech32Address, bech32Separator)+1:]
+	if !strings.HasPrefix(dataPart, generator.prefix) || !strings.HasSuffix(dataPart, generator.suffix) {
+		return nil, nil
+	}
+
+	return &VanityAddress{
+		Address:    bech32Address,
+		ShardID:    shardID,
+		PrivateKey: privateKey,
+	}, nil
+}
+
+func (generator *vanityAddressGenerator) reportStats(attempts uint64, found int, startTime time.Time) {
+	if generator.statsHandler == nil {
+		return
+	}
+
+	generator.statsHandler(VanityGeneratorStats{
+		Attempts: attempts,
+		Found:    found,
+		Elapsed:  time.Since(startTime),
+	})
+}
+
+// SaveAddressesToPemFile appends the private keys of all the addresses to a multi-key .pem file
+func (generator *vanityAddressGenerator) SaveAddressesToPemFile(filename string, addresses []*VanityAddress) error {
+	for _, address := range addresses {
+		err := generator.wallet.AppendPrivateKeyToPemFile(address.PrivateKey, filename)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// SaveAddressesToJsonFiles saves the password encrypted private key of each address in the <bech32 address>.json file
+// of the provided directory
+func (generator *vanityAddressGenerator) SaveAddressesToJsonFiles(
+	directory string,
+	password string,
+	params KeystoreKDFParams,
+	addresses []*VanityAddress,
+) error {
+	for _, address := range addresses {
+		filename := filepath.Join(directory, address.Address+".json")
+		err := generator.wallet.SavePrivateKeyToJsonFileWithKDFParams(address.PrivateKey, password, filename, params)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (generator *vanityAddressGenerator) IsInterfaceNil() bool {
+	return generator == nil
+}
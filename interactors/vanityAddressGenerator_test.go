@@ -0,0 +1,211 @@
+package interactors
+
+import (
+	"context"
+	"errors"
+	"path"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-sdk-go/blockchain"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func createMockArgsVanityAddressGenerator(t *testing.T) ArgsVanityAddressGenerator {
+	shardCoordinator, err := blockchain.NewShardCoordinator(3, 0)
+	require.Nil(t, err)
+
+	return ArgsVanityAddressGenerator{
+		ShardCoordinator: shardCoordinator,
+		TargetShard:      1,
+		NumWorkers:       2,
+	}
+}
+
+func TestNewVanityAddressGenerator(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil shard coordinator should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsVanityAddressGenerator(t)
+		args.ShardCoordinator = nil
+		generator, err := NewVanityAddressGenerator(args)
+		assert.True(t, check.IfNil(generator))
+		assert.Equal(t, ErrNilShardCoordinator, err)
+	})
+	t.Run("invalid target shard should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsVanityAddressGenerator(t)
+		args.TargetShard = 3
+		generator, err := NewVanityAddressGenerator(args)
+		assert.True(t, check.IfNil(generator))
+		assert.True(t, errors.Is(err, ErrInvalidTargetShard))
+
+		args.TargetShard = core.MetachainShardId
+		generator, err = NewVanityAddressGenerator(args)
+		assert.True(t, check.IfNil(generator))
+		assert.True(t, errors.Is(err, ErrInvalidTargetShard))
+	})
+	t.Run("invalid pattern should error", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsVanityAddressGenerator(t)
+		args.Prefix = "abc"
+		generator, err := NewVanityAddressGenerator(args)
+		assert.True(t, check.IfNil(generator))
+		assert.True(t, errors.Is(err, ErrInvalidVanityPattern))
+
+		args.Prefix = ""
+		args.Suffix = "1"
+		generator, err = NewVanityAddressGenerator(args)
+		assert.True(t, check.IfNil(generator))
+		assert.True(t, errors.Is(err, ErrInvalidVanityPattern))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsVanityAddressGenerator(t)
+		args.Prefix = "QQ"
+		args.NumWorkers = 0
+		generator, err := NewVanityAddressGenerator(args)
+		assert.False(t, check.IfNil(generator))
+		assert.Nil(t, err)
+		assert.Equal(t, "qq", generator.prefix)
+		assert.True(t, generator.numWorkers > 0)
+	})
+}
+
+func TestVanityAddressGenerator_Generate(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid number of addresses should error", func(t *testing.T) {
+		t.Parallel()
+
+		generator, _ := NewVanityAddressGenerator(createMockArgsVanityAddressGenerator(t))
+		addresses, err := generator.Generate(context.Background(), 0)
+		assert.Nil(t, addresses)
+		assert.True(t, errors.Is(err, ErrInvalidNumberOfAddresses))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsVanityAddressGenerator(t)
+		args.Prefix = "q"
+		args.Suffix = "x"
+		mutStats := sync.Mutex{}
+		reportedStats := make([]VanityGeneratorStats, 0)
+		args.StatsHandler = func(stats VanityGeneratorStats) {
+			mutStats.Lock()
+			reportedStats = append(reportedStats, stats)
+			mutStats.Unlock()
+		}
+		args.StatsInterval = time.Millisecond
+		generator, _ := NewVanityAddressGenerator(args)
+
+		addresses, err := generator.Generate(context.Background(), 3)
+		require.Nil(t, err)
+		require.Len(t, addresses, 3)
+
+		w := NewWallet()
+		for _, address := range addresses {
+			assert.True(t, strings.HasPrefix(address.Address, "erd1q"))
+			assert.True(t, strings.HasSuffix(address.Address, "x"))
+			assert.Equal(t, uint32(1), address.ShardID)
+
+			computedAddress, errAddress := w.GetAddressFromPrivateKey(address.PrivateKey)
+			require.Nil(t, errAddress)
+			shardID, _ := args.ShardCoordinator.ComputeShardId(computedAddress)
+			assert.Equal(t, uint32(1), shardID)
+			bech32Address, _ := computedAddress.AddressAsBech32String()
+			assert.Equal(t, address.Address, bech32Address)
+		}
+
+		mutStats.Lock()
+		defer mutStats.Unlock()
+		require.NotEmpty(t, reportedStats)
+		lastStats := reportedStats[len(reportedStats)-1]
+		assert.Equal(t, 3, lastStats.Found)
+		assert.True(t, lastStats.Attempts >= 3)
+		assert.True(t, lastStats.AttemptsPerSecond() > 0)
+	})
+	t.Run("cancelled context should return the addresses found so far", func(t *testing.T) {
+		t.Parallel()
+
+		args := createMockArgsVanityAddressGenerator(t)
+		args.Prefix = "qqqqqqqqqqqqqqqq"
+		var lastStats VanityGeneratorStats
+		args.StatsHandler = func(stats VanityGeneratorStats) {
+			lastStats = stats
+		}
+		generator, _ := NewVanityAddressGenerator(args)
+
+		ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+		defer cancel()
+		addresses, err := generator.Generate(ctx, 1)
+		assert.Empty(t, addresses)
+		assert.Equal(t, context.DeadlineExceeded, err)
+		assert.Equal(t, 0, lastStats.Found)
+		assert.True(t, lastStats.Attempts > 0)
+	})
+}
+
+func TestVanityGeneratorStats_AttemptsPerSecond(t *testing.T) {
+	t.Parallel()
+
+	assert.Equal(t, float64(0), VanityGeneratorStats{Attempts: 10}.AttemptsPerSecond())
+	assert.Equal(t, float64(5), VanityGeneratorStats{Attempts: 10, Elapsed: time.Second * 2}.AttemptsPerSecond())
+}
+
+func TestVanityAddressGenerator_SaveAddresses(t *testing.T) {
+	t.Parallel()
+
+	generator, _ := NewVanityAddressGenerator(createMockArgsVanityAddressGenerator(t))
+	addresses, err := generator.Generate(context.Background(), 2)
+	require.Nil(t, err)
+
+	w := NewWallet()
+
+	t.Run("pem file should work", func(t *testing.T) {
+		t.Parallel()
+
+		filename := path.Join(t.TempDir(), "vanity.pem")
+		err := generator.SaveAddressesToPemFile(filename, addresses)
+		require.Nil(t, err)
+
+		keys, err := w.LoadAllPrivateKeysFromPemFile(filename)
+		require.Nil(t, err)
+		require.Len(t, keys, 2)
+		for i, key := range keys {
+			assert.Equal(t, addresses[i].Address, key.Label)
+			assert.Equal(t, addresses[i].PrivateKey, key.PrivateKey)
+		}
+	})
+	t.Run("json files should work", func(t *testing.T) {
+		t.Parallel()
+
+		params := DefaultKeystoreKDFParams()
+		params.N = 8192
+		directory := t.TempDir()
+		err := generator.SaveAddressesToJsonFiles(directory, "password", params, addresses)
+		require.Nil(t, err)
+
+		for _, address := range addresses {
+			privateKey, errLoad := w.LoadPrivateKeyFromJsonFile(path.Join(directory, address.Address+".json"), "password")
+			require.Nil(t, errLoad)
+			assert.Equal(t, address.PrivateKey, privateKey)
+		}
+	})
+	t.Run("missing directory should error", func(t *testing.T) {
+		t.Parallel()
+
+		err := generator.SaveAddressesToJsonFiles(path.Join(t.TempDir(), "missing"), "password", DefaultKeystoreKDFParams(), addresses)
+		assert.NotNil(t, err)
+	})
+}
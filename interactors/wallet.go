@@ -1,27 +1,18 @@
 package interactors
 
 import (
-	"bytes"
-	"crypto/aes"
-	"crypto/cipher"
 	"crypto/hmac"
-	"crypto/rand"
-	"crypto/sha256"
 	"crypto/sha512"
 	"encoding/binary"
 	"encoding/hex"
-	"encoding/json"
 	"encoding/pem"
-	"io"
+	"fmt"
 	"os"
 
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
 	"github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/data"
-	"github.com/pborman/uuid"
-	"github.com/tyler-smith/go-bip39"
-	"golang.org/x/crypto/scrypt"
 )
 
 const (
@@ -73,26 +64,25 @@ type encryptedKeyJSONV4 struct {
 }
 
 type wallet struct {
+	addressConverter core.AddressConverter
 }
 
 // NewWallet creates a new wallet instance
 func NewWallet() *wallet {
-	return &wallet{}
+	return NewWalletWithAddressConverter(core.AddressPublicKeyConverter)
 }
 
-// GenerateMnemonic will generate a new mnemonic value using the bip39 implementation
-func (w *wallet) GenerateMnemonic() (data.Mnemonic, error) {
-	entropy, err := bip39.NewEntropy(mnemonicBitSize)
-	if err != nil {
-		return "", err
-	}
-
-	mnemonicString, err := bip39.NewMnemonic(entropy)
-	if err != nil {
-		return "", err
+// NewWalletWithAddressConverter creates a new wallet instance that generates the addresses (and their bech32 forms
+// found in the .pem labels and the keystores) using the provided address converter. A nil converter means the default one
+func NewWalletWithAddressConverter(addressConverter core.AddressConverter) *wallet {
+	return &wallet{
+		addressConverter: core.AddressConverterOrDefault(addressConverter),
 	}
+}
 
-	return data.Mnemonic(mnemonicString), nil
+// GenerateMnemonic will generate a new mnemonic value using the bip39 implementation and the English wordlist
+func (w *wallet) GenerateMnemonic() (data.Mnemonic, error) {
+	return w.GenerateMnemonicInLanguage(MnemonicLanguageEnglish)
 }
 
 // GetPrivateKeyFromMnemonic generates a private key based on mnemonic, account and address index
@@ -119,9 +109,9 @@ func (w *wallet) GetPrivateKeyFromSeed(seed []byte, account, addressIndex uint32
 	return keyData.Key
 }
 
-// CreateSeedFromMnemonic creates a seed for a given mnemonic
+// CreateSeedFromMnemonic creates a seed for a given mnemonic, using an empty passphrase
 func (w *wallet) CreateSeedFromMnemonic(mnemonic data.Mnemonic) []byte {
-	seed := bip39.NewSeed(string(mnemonic), "")
+	seed := createSeed(string(mnemonic), "")
 	return seed
 }
 
@@ -162,75 +152,41 @@ func (w *wallet) GetAddressFromPrivateKey(privateKeyBytes []byte) (core.AddressH
 		return nil, err
 	}
 
-	return data.NewAddressFromBytes(publicKeyBytes), nil
+	return data.NewAddressFromBytesWithConverter(publicKeyBytes, w.addressConverter), nil
 }
 
-// LoadPrivateKeyFromJsonFile loads a password encrypted private key from a .json file
+// LoadPrivateKeyFromJsonFile loads a password encrypted private key from a .json file. For the mnemonic kind
+// keystores, the private key of the first account and address index is returned, derived with an empty passphrase
 func (w *wallet) LoadPrivateKeyFromJsonFile(filename string, password string) ([]byte, error) {
-	buff, err := os.ReadFile(filename)
-	if err != nil {
-		return nil, err
-	}
-
-	key := &encryptedKeyJSONV4{}
-	err = json.Unmarshal(buff, key)
-	if err != nil {
-		return nil, err
-	}
-
-	mac, err := hex.DecodeString(key.Crypto.MAC)
-	if err != nil {
-		return nil, err
-	}
-
-	iv, err := hex.DecodeString(key.Crypto.CipherParams.IV)
-	if err != nil {
-		return nil, err
-	}
-
-	cipherText, err := hex.DecodeString(key.Crypto.CipherText)
-	if err != nil {
-		return nil, err
-	}
-
-	salt, err := hex.DecodeString(key.Crypto.KDFParams.Salt)
-	if err != nil {
-		return nil, err
-	}
-
-	derivedKey, err := scrypt.Key([]byte(password), salt,
-		key.Crypto.KDFParams.N,
-		key.Crypto.KDFParams.R,
-		key.Crypto.KDFParams.P,
-		key.Crypto.KDFParams.DkLen)
-	if err != nil {
-		return nil, err
-	}
+	return w.LoadPrivateKeyFromJsonFileWithPassphrase(filename, password, "")
+}
 
-	hash := hmac.New(sha256.New, derivedKey[16:32])
-	_, err = hash.Write(cipherText)
+// LoadPrivateKeyFromJsonFileWithPassphrase loads a password encrypted private key from a .json file. For the mnemonic
+// kind keystores, the private key of the first account and address index is returned, derived with the provided BIP39
+// passphrase. The passphrase is not used for the secretKey kind keystores
+func (w *wallet) LoadPrivateKeyFromJsonFileWithPassphrase(filename string, password string, passphrase string) ([]byte, error) {
+	key, err := readKeystoreFile(filename)
 	if err != nil {
 		return nil, err
 	}
 
-	sha := hash.Sum(nil)
-	if !bytes.Equal(sha, mac) {
-		return nil, ErrWrongPassword
-	}
-
-	aesBlock, err := aes.NewCipher(derivedKey[:16])
+	decryptedData, err := decryptKeystore(key, password)
 	if err != nil {
 		return nil, err
 	}
 
-	stream := cipher.NewCTR(aesBlock, iv)
-	decryptedData := make([]byte, len(cipherText))
-	stream.XORKeyStream(decryptedData, cipherText)
-
-	if key.Kind != mnemonicKind { // wallets with the old JSON format
+	switch key.Kind {
+	case "", KeystoreKindSecretKey: // wallets with the old JSON format do not have the kind field
 		return w.secretKeyAfterChecks(key, decryptedData)
-	} else {
-		return w.secretKeyFromMnemonic(decryptedData), nil
+	case KeystoreKindMnemonic:
+		mnemonic, errCheck := checkMnemonic(decryptedData)
+		if errCheck != nil {
+			return nil, errCheck
+		}
+
+		return w.secretKeyFromMnemonic(mnemonic, passphrase), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKeystoreKind, key.Kind)
 	}
 }
 
@@ -257,47 +213,23 @@ func (w *wallet) secretKeyAfterChecks(key *encryptedKeyJSONV4, secretKey []byte)
 	return secretKey, nil
 }
 
-func (w *wallet) secretKeyFromMnemonic(mnemonic []byte) []byte {
-	return w.GetPrivateKeyFromMnemonic(data.Mnemonic(mnemonic), 0, 0)
+func (w *wallet) secretKeyFromMnemonic(mnemonic []byte, passphrase string) []byte {
+	return w.GetPrivateKeyFromSeed(createSeed(string(mnemonic), passphrase), 0, 0)
 }
 
-// SavePrivateKeyToJsonFile saves a password encrypted private key to a .json file
+// SavePrivateKeyToJsonFile saves a password encrypted private key to a .json file, using the default KDF parameters
 func (w *wallet) SavePrivateKeyToJsonFile(privateKey []byte, password string, filename string) error {
-	salt := make([]byte, 32)
-	_, err := io.ReadFull(rand.Reader, salt)
-	if err != nil {
-		return err
-	}
-
-	derivedKey, err := scrypt.Key([]byte(password), salt, scryptN, scryptR, scryptP, scryptDKLen)
-	if err != nil {
-		return err
-	}
-
-	encryptKey := derivedKey[:16]
-	iv := make([]byte, aes.BlockSize) // 16
-	_, err = io.ReadFull(rand.Reader, iv)
-	if err != nil {
-		return err
-	}
-
-	aesBlock, err := aes.NewCipher(encryptKey)
-	if err != nil {
-		return err
-	}
-
-	stream := cipher.NewCTR(aesBlock, iv)
-	cipherText := make([]byte, len(privateKey))
-	stream.XORKeyStream(cipherText, privateKey)
-
-	hash := hmac.New(sha256.New, derivedKey[16:32])
-	_, err = hash.Write(cipherText)
-	if err != nil {
-		return err
-	}
-
-	mac := hash.Sum(nil)
+	return w.SavePrivateKeyToJsonFileWithKDFParams(privateKey, password, filename, DefaultKeystoreKDFParams())
+}
 
+// SavePrivateKeyToJsonFileWithKDFParams saves a password encrypted private key to a secretKey kind .json file,
+// using the provided KDF parameters
+func (w *wallet) SavePrivateKeyToJsonFileWithKDFParams(
+	privateKey []byte,
+	password string,
+	filename string,
+	params KeystoreKDFParams,
+) error {
 	address, err := w.GetAddressFromPrivateKey(privateKey)
 	if err != nil {
 		return err
@@ -308,29 +240,15 @@ func (w *wallet) SavePrivateKeyToJsonFile(privateKey []byte, password string, fi
 		return err
 	}
 
-	keystoreJson := &encryptedKeyJSONV4{
-		Bech32:  addressAsBech32String,
-		Address: hex.EncodeToString(address.AddressBytes()),
-		Version: keystoreVersion,
-		Id:      uuid.New(),
-	}
-	keystoreJson.Crypto.CipherParams.IV = hex.EncodeToString(iv)
-	keystoreJson.Crypto.Cipher = "aes-128-ctr"
-	keystoreJson.Crypto.CipherText = hex.EncodeToString(cipherText)
-	keystoreJson.Crypto.KDF = keyHeaderKDF
-	keystoreJson.Crypto.MAC = hex.EncodeToString(mac)
-	keystoreJson.Crypto.KDFParams.N = scryptN
-	keystoreJson.Crypto.KDFParams.R = scryptR
-	keystoreJson.Crypto.KDFParams.P = scryptP
-	keystoreJson.Crypto.KDFParams.DkLen = scryptDKLen
-	keystoreJson.Crypto.KDFParams.Salt = hex.EncodeToString(salt)
-
-	buff, err := json.Marshal(keystoreJson)
+	key, err := encryptKeystore(privateKey, password, params)
 	if err != nil {
 		return err
 	}
+	key.Kind = KeystoreKindSecretKey
+	key.Bech32 = addressAsBech32String
+	key.Address = hex.EncodeToString(address.AddressBytes())
 
-	return os.WriteFile(filename, buff, 0644)
+	return writeKeystoreFile(filename, key)
 }
 
 // LoadPrivateKeyFromPemFile loads a private key from a .pem file
@@ -343,13 +261,18 @@ func (w *wallet) LoadPrivateKeyFromPemFile(filename string) ([]byte, error) {
 	return w.LoadPrivateKeyFromPemData(buff)
 }
 
-// LoadPrivateKeyFromPemData returns the private key from decoded pem data
+// LoadPrivateKeyFromPemData returns the private key from decoded pem data. If the data holds more than one key,
+// the first one is returned
 func (w *wallet) LoadPrivateKeyFromPemData(buff []byte) ([]byte, error) {
 	blk, _ := pem.Decode(buff)
 	if blk == nil {
 		return nil, ErrInvalidPemFile
 	}
 
+	return privateKeyFromPemBlock(blk)
+}
+
+func privateKeyFromPemBlock(blk *pem.Block) ([]byte, error) {
 	privKey := blk.Bytes
 	if len(privKey) > addressLen*2 {
 		privKey = privKey[:addressLen*2]
@@ -360,30 +283,43 @@ func (w *wallet) LoadPrivateKeyFromPemData(buff []byte) ([]byte, error) {
 
 // SavePrivateKeyToPemFile saves the private key in a .pem file
 func (w *wallet) SavePrivateKeyToPemFile(privateKey []byte, filename string) error {
-	address, err := w.GetAddressFromPrivateKey(privateKey)
+	blk, err := w.createPemBlock(privateKey)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY, 0600)
 	if err != nil {
 		return err
 	}
+	defer func() {
+		_ = file.Close()
+	}()
+
+	return pem.Encode(file, blk)
+}
+
+func (w *wallet) createPemBlock(privateKey []byte) (*pem.Block, error) {
+	address, err := w.GetAddressFromPrivateKey(privateKey)
+	if err != nil {
+		return nil, err
+	}
 	if len(privateKey) == addressLen {
 		privateKey = append(privateKey, address.AddressBytes()...)
 	}
 
 	addressAsBech32String, err := address.AddressAsBech32String()
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	blk := pem.Block{
-		Type:  "PRIVATE KEY for " + addressAsBech32String,
+	return &pem.Block{
+		Type:  pemLabelPrefix + addressAsBech32String,
 		Bytes: []byte(hex.EncodeToString(privateKey)),
-	}
-	file, err := os.OpenFile(filename, os.O_CREATE|os.O_WRONLY, 0600)
-	if err != nil {
-		return err
-	}
-	defer func() {
-		_ = file.Close()
-	}()
+	}, nil
+}
 
-	return pem.Encode(file, &blk)
+// IsInterfaceNil returns true if there is no value under the interface
+func (w *wallet) IsInterfaceNil() bool {
+	return w == nil
 }
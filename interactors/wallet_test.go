@@ -7,6 +7,7 @@ import (
 	"path"
 	"testing"
 
+	"github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/data"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
@@ -87,6 +88,62 @@ func TestWallet_GetAddressFromPrivateKey(t *testing.T) {
 	assert.Equal(t, expectedBech32Addr, addressAsBech32String)
 }
 
+func TestWallet_WithAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	converter, err := core.NewAddressPublicKeyConverter("sov")
+	require.Nil(t, err)
+	privKey, err := hex.DecodeString("0b7966138e80b8f3bb64046f56aea4250fd7bacad6ed214165cea6767fd0bc2c")
+	require.Nil(t, err)
+
+	w := NewWalletWithAddressConverter(converter)
+	defaultWallet := NewWallet()
+
+	address, err := w.GetAddressFromPrivateKey(privKey)
+	require.Nil(t, err)
+	defaultAddress, err := defaultWallet.GetAddressFromPrivateKey(privKey)
+	require.Nil(t, err)
+	assert.Equal(t, defaultAddress.AddressBytes(), address.AddressBytes())
+
+	bech32Address, err := address.AddressAsBech32String()
+	require.Nil(t, err)
+	assert.Equal(t, "sov1", bech32Address[:4])
+	defaultBech32Address, err := defaultAddress.AddressAsBech32String()
+	require.Nil(t, err)
+	assert.Equal(t, "erd1mlh7q3fcgrjeq0et65vaaxcw6m5ky8jhu296pdxpk9g32zga6uhsemxx2a", defaultBech32Address)
+
+	t.Run("pem file should use the custom prefix", func(t *testing.T) {
+		t.Parallel()
+
+		filename := path.Join(t.TempDir(), "sov.pem")
+		errSave := w.SavePrivateKeyToPemFile(privKey, filename)
+		require.Nil(t, errSave)
+
+		keys, errLoad := w.LoadAllPrivateKeysFromPemFile(filename)
+		require.Nil(t, errLoad)
+		require.Len(t, keys, 1)
+		assert.Equal(t, bech32Address, keys[0].Label)
+		assert.Equal(t, privKey, keys[0].PrivateKey)
+	})
+	t.Run("json file should use the custom prefix", func(t *testing.T) {
+		t.Parallel()
+
+		params := DefaultKeystoreKDFParams()
+		params.N = 8192
+		filename := path.Join(t.TempDir(), "sov.json")
+		errSave := w.SavePrivateKeyToJsonFileWithKDFParams(privKey, "password", filename, params)
+		require.Nil(t, errSave)
+
+		buff, errRead := os.ReadFile(filename)
+		require.Nil(t, errRead)
+		assert.Contains(t, string(buff), bech32Address)
+
+		recoveredSk, errLoad := w.LoadPrivateKeyFromJsonFile(filename, "password")
+		require.Nil(t, errLoad)
+		assert.Equal(t, privKey, recoveredSk)
+	})
+}
+
 func TestWallet_LoadPrivateKeyFromJsonFile(t *testing.T) {
 	t.Parallel()
 
@@ -118,6 +175,39 @@ func TestWallet_LoadPrivateKeyFromJsonFileWithKind(t *testing.T) {
 	require.Equal(t, "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th", addressAsBech32String)
 }
 
+func TestWallet_LoadPrivateKeyFromJsonFileWithPassphrase(t *testing.T) {
+	t.Parallel()
+
+	filename := "testdata/testWithKind.json"
+	password := "password"
+	w := NewWallet()
+	mnemonic, err := w.LoadMnemonicFromJsonFile(filename, password)
+	require.Nil(t, err)
+
+	t.Run("empty passphrase should return the same key", func(t *testing.T) {
+		t.Parallel()
+
+		privkey, errLoad := w.LoadPrivateKeyFromJsonFileWithPassphrase(filename, password, "")
+		require.Nil(t, errLoad)
+		assert.Equal(t, "413f42575f7f26fad3317a778771212fdb80245850981e48b58a4f25e344e8f9", hex.EncodeToString(privkey))
+	})
+	t.Run("passphrase should work", func(t *testing.T) {
+		t.Parallel()
+
+		options := MnemonicOptions{
+			Language:   MnemonicLanguageEnglish,
+			Passphrase: "25th word",
+		}
+		expectedPrivkey, errDerive := w.GetPrivateKeyFromMnemonicWithOptions(mnemonic, options, 0, 0)
+		require.Nil(t, errDerive)
+
+		privkey, errLoad := w.LoadPrivateKeyFromJsonFileWithPassphrase(filename, password, options.Passphrase)
+		require.Nil(t, errLoad)
+		assert.Equal(t, expectedPrivkey, privkey)
+		assert.NotEqual(t, "413f42575f7f26fad3317a778771212fdb80245850981e48b58a4f25e344e8f9", hex.EncodeToString(privkey))
+	})
+}
+
 func TestWallet_SavePrivateKeyToJsonFile(t *testing.T) {
 	t.Parallel()
 
@@ -3,15 +3,23 @@ package main
 import (
 	"encoding/hex"
 	"log"
+	"strings"
 
+	crypto "github.com/multiversx/mx-chain-crypto-go"
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/multisig"
 	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/multiversx/mx-sdk-go/data"
 )
 
+// listSeparator separates the hex encoded items of the input parameters holding more than one value
+const listSeparator = ","
+
 var (
-	keyGenerator = signing.NewKeyGenerator(mcl.NewSuiteBLS12())
-	blsSigner    = singlesig.BlsSingleSigner{}
+	keyGenerator   = signing.NewKeyGenerator(mcl.NewSuiteBLS12())
+	blsSigner      = singlesig.BlsSingleSigner{}
+	blsMultiSigner = multisig.BlsMultiSignerKOSK{}
 )
 
 func doGeneratePrivateKeyAsHex() string {
@@ -99,6 +107,189 @@ func doVerifyMessageSignature(publicKeyHex string, messageHex string, signatureH
 	return err == nil
 }
 
+// doAggregatePublicKeysAsHex returns the sum of the provided public keys, that can verify an aggregated signature
+// produced by all the corresponding private keys
+func doAggregatePublicKeysAsHex(publicKeysHex string) string {
+	publicKeys, ok := decodePublicKeys(publicKeysHex)
+	if !ok {
+		return ""
+	}
+
+	aggregatedPoint := keyGenerator.Suite().CreatePoint().Null()
+	for _, publicKey := range publicKeys {
+		var err error
+		aggregatedPoint, err = aggregatedPoint.Add(publicKey.Point())
+		if err != nil {
+			log.Println("doAggregatePublicKeysAsHex(): error when adding the public key", err)
+			return ""
+		}
+	}
+
+	aggregatedPublicKeyBytes, err := aggregatedPoint.MarshalBinary()
+	if err != nil {
+		log.Println("doAggregatePublicKeysAsHex(): error when decoding the aggregated public key", err)
+		return ""
+	}
+
+	return hex.EncodeToString(aggregatedPublicKeyBytes)
+}
+
+// doAggregateSignaturesAsHex returns the aggregation of the provided signatures, all of them produced over the same message
+func doAggregateSignaturesAsHex(signaturesHex string) string {
+	signatures, ok := decodeInputList("signatures", signaturesHex)
+	if !ok {
+		return ""
+	}
+
+	aggregatedPoint := mcl.NewPointG1().Null()
+	for _, signature := range signatures {
+		err := blsMultiSigner.VerifySigBytes(keyGenerator.Suite(), signature)
+		if err != nil {
+			log.Println("doAggregateSignaturesAsHex(): invalid signature", err)
+			return ""
+		}
+
+		signaturePoint := mcl.NewPointG1()
+		err = signaturePoint.UnmarshalBinary(signature)
+		if err != nil {
+			log.Println("doAggregateSignaturesAsHex(): error when decoding the signature", err)
+			return ""
+		}
+
+		aggregatedPoint, err = aggregatedPoint.Add(signaturePoint)
+		if err != nil {
+			log.Println("doAggregateSignaturesAsHex(): error when adding the signature", err)
+			return ""
+		}
+	}
+
+	aggregatedSignature, err := aggregatedPoint.MarshalBinary()
+	if err != nil {
+		log.Println("doAggregateSignaturesAsHex(): error when decoding the aggregated signature", err)
+		return ""
+	}
+
+	return hex.EncodeToString(aggregatedSignature)
+}
+
+// doVerifyAggregatedSignature checks that the aggregated signature was produced over the message by the private keys
+// of all the provided public keys. The public keys should have their proofs of possession checked beforehand
+func doVerifyAggregatedSignature(publicKeysHex string, messageHex string, aggregatedSignatureHex string) bool {
+	publicKeys, ok := decodePublicKeys(publicKeysHex)
+	if !ok {
+		return false
+	}
+
+	message, ok := decodeInputParameter("message", messageHex)
+	if !ok {
+		return false
+	}
+
+	aggregatedSignature, ok := decodeInputParameter("aggregated signature", aggregatedSignatureHex)
+	if !ok {
+		return false
+	}
+
+	err := blsMultiSigner.VerifyAggregatedSig(keyGenerator.Suite(), publicKeys, aggregatedSignature, message)
+	return err == nil
+}
+
+// doComputeProofOfPossessionAsHex returns the proof of possession of the private key: the signature of its own public key
+func doComputeProofOfPossessionAsHex(privateKeyHex string) string {
+	privateKeyBytes, ok := decodeInputParameter("private key", privateKeyHex)
+	if !ok {
+		return ""
+	}
+
+	privateKey, err := keyGenerator.PrivateKeyFromByteArray(privateKeyBytes)
+	if err != nil {
+		log.Println("doComputeProofOfPossessionAsHex(): error when creating the private key", err)
+		return ""
+	}
+
+	publicKeyBytes, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		log.Println("doComputeProofOfPossessionAsHex(): error when decoding the public key", err)
+		return ""
+	}
+
+	proof, err := blsSigner.Sign(privateKey, publicKeyBytes)
+	if err != nil {
+		log.Println("doComputeProofOfPossessionAsHex(): error when signing the public key", err)
+		return ""
+	}
+
+	return hex.EncodeToString(proof)
+}
+
+// doVerifyProofOfPossession checks that the proof was produced by the owner of the public key
+func doVerifyProofOfPossession(publicKeyHex string, proofHex string) bool {
+	return doVerifyMessageSignature(publicKeyHex, publicKeyHex, proofHex)
+}
+
+// doComputeOwnAddressSignatureAsHex returns the signature of the staking (owner) address, required by the staking
+// system smart contract when registering the validator key
+func doComputeOwnAddressSignatureAsHex(addressBech32 string, privateKeyHex string) string {
+	address, err := data.NewAddressFromBech32String(addressBech32)
+	if err != nil {
+		log.Println("doComputeOwnAddressSignatureAsHex(): error when decoding the address", err)
+		return ""
+	}
+
+	return doComputeMessageSignatureAsHex(hex.EncodeToString(address.AddressBytes()), privateKeyHex)
+}
+
+// doVerifyOwnAddressSignature checks the signature of the staking (owner) address produced by the validator key
+func doVerifyOwnAddressSignature(publicKeyHex string, addressBech32 string, signatureHex string) bool {
+	address, err := data.NewAddressFromBech32String(addressBech32)
+	if err != nil {
+		log.Println("doVerifyOwnAddressSignature(): error when decoding the address", err)
+		return false
+	}
+
+	return doVerifyMessageSignature(publicKeyHex, hex.EncodeToString(address.AddressBytes()), signatureHex)
+}
+
+func decodePublicKeys(publicKeysHex string) ([]crypto.PublicKey, bool) {
+	publicKeysBytes, ok := decodeInputList("public keys", publicKeysHex)
+	if !ok {
+		return nil, false
+	}
+
+	publicKeys := make([]crypto.PublicKey, 0, len(publicKeysBytes))
+	for _, publicKeyBytes := range publicKeysBytes {
+		publicKey, err := keyGenerator.PublicKeyFromByteArray(publicKeyBytes)
+		if err != nil {
+			log.Println("cannot create the public key", err)
+			return nil, false
+		}
+
+		publicKeys = append(publicKeys, publicKey)
+	}
+
+	return publicKeys, true
+}
+
+func decodeInputList(parameterName string, listHex string) ([][]byte, bool) {
+	if len(strings.TrimSpace(listHex)) == 0 {
+		log.Println("empty input parameter", parameterName)
+		return nil, false
+	}
+
+	items := strings.Split(listHex, listSeparator)
+	list := make([][]byte, 0, len(items))
+	for _, itemHex := range items {
+		item, ok := decodeInputParameter(parameterName, strings.TrimSpace(itemHex))
+		if !ok {
+			return nil, false
+		}
+
+		list = append(list, item)
+	}
+
+	return list, true
+}
+
 func decodeInputParameter(parameterName string, parameterValueHex string) ([]byte, bool) {
 	data, err := hex.DecodeString(parameterValueHex)
 	if err != nil {
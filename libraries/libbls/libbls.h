@@ -72,6 +72,13 @@ extern char* generatePublicKey(char* privateKey);
 extern char* computeMessageSignature(char* message, char* privateKey);
 extern GoInt verifyMessageSignature(char* publicKey, char* message, char* signature);
 extern char* generatePrivateKey();
+extern char* aggregatePublicKeys(char* publicKeys);
+extern char* aggregateSignatures(char* signatures);
+extern GoInt verifyAggregatedSignature(char* publicKeys, char* message, char* aggregatedSignature);
+extern char* computeProofOfPossession(char* privateKey);
+extern GoInt verifyProofOfPossession(char* publicKey, char* proof);
+extern char* computeOwnAddressSignature(char* address, char* privateKey);
+extern GoInt verifyOwnAddressSignature(char* publicKey, char* address, char* signature);
 
 #ifdef __cplusplus
 }
@@ -2,6 +2,7 @@ package main
 
 import (
 	"encoding/hex"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -87,3 +88,97 @@ func TestGenerateSignAndVerify(t *testing.T) {
 
 	require.True(t, isOk)
 }
+
+func TestAggregateSignAndVerify(t *testing.T) {
+	messageHex := hex.EncodeToString([]byte("hello"))
+
+	numSigners := 3
+	publicKeysHex := make([]string, 0, numSigners)
+	signaturesHex := make([]string, 0, numSigners)
+	for i := 0; i < numSigners; i++ {
+		privateKeyHex := doGeneratePrivateKeyAsHex()
+		publicKeysHex = append(publicKeysHex, doGeneratePublicKeyAsHex(privateKeyHex))
+		signaturesHex = append(signaturesHex, doComputeMessageSignatureAsHex(messageHex, privateKeyHex))
+	}
+
+	publicKeys := strings.Join(publicKeysHex, listSeparator)
+	aggregatedSignatureHex := doAggregateSignaturesAsHex(strings.Join(signaturesHex, listSeparator))
+	require.NotEmpty(t, aggregatedSignatureHex)
+
+	t.Run("with good input", func(t *testing.T) {
+		require.True(t, doVerifyAggregatedSignature(publicKeys, messageHex, aggregatedSignatureHex))
+	})
+
+	t.Run("with the aggregated public key", func(t *testing.T) {
+		aggregatedPublicKeyHex := doAggregatePublicKeysAsHex(publicKeys)
+		require.True(t, doVerifyMessageSignature(aggregatedPublicKeyHex, messageHex, aggregatedSignatureHex))
+	})
+
+	t.Run("with missing signer", func(t *testing.T) {
+		missingSigner := strings.Join(publicKeysHex[:numSigners-1], listSeparator)
+		require.False(t, doVerifyAggregatedSignature(missingSigner, messageHex, aggregatedSignatureHex))
+	})
+
+	t.Run("with altered message", func(t *testing.T) {
+		require.False(t, doVerifyAggregatedSignature(publicKeys, hex.EncodeToString([]byte("helloWorld")), aggregatedSignatureHex))
+	})
+
+	t.Run("with bad input", func(t *testing.T) {
+		require.Equal(t, "", doAggregateSignaturesAsHex(""))
+		require.Equal(t, "", doAggregateSignaturesAsHex(signaturesHex[0]+listSeparator+"not hex"))
+		require.Equal(t, "", doAggregatePublicKeysAsHex(""))
+		require.Equal(t, "", doAggregatePublicKeysAsHex(publicKeysHex[0]+listSeparator+"badbad"))
+		require.False(t, doVerifyAggregatedSignature("", messageHex, aggregatedSignatureHex))
+	})
+}
+
+func TestDoComputeAndVerifyProofOfPossession(t *testing.T) {
+	privateKeyHex := "7cff99bd671502db7d15bc8abc0c9a804fb925406fbdd50f1e4c17a4cd774247"
+	publicKeyHex := "e7beaa95b3877f47348df4dd1cb578a4f7cabf7a20bfeefe5cdd263878ff132b765e04fef6f40c93512b666c47ed7719b8902f6c922c04247989b7137e837cc81a62e54712471c97a2ddab75aa9c2f58f813ed4c0fa722bde0ab718bff382208"
+
+	proofHex := doComputeProofOfPossessionAsHex(privateKeyHex)
+	require.NotEmpty(t, proofHex)
+
+	t.Run("with good input", func(t *testing.T) {
+		require.True(t, doVerifyProofOfPossession(publicKeyHex, proofHex))
+	})
+
+	t.Run("with another public key", func(t *testing.T) {
+		otherPublicKeyHex := doGeneratePublicKeyAsHex(doGeneratePrivateKeyAsHex())
+		require.False(t, doVerifyProofOfPossession(otherPublicKeyHex, proofHex))
+	})
+
+	t.Run("with a message signature", func(t *testing.T) {
+		signatureHex := doComputeMessageSignatureAsHex(hex.EncodeToString([]byte("hello")), privateKeyHex)
+		require.False(t, doVerifyProofOfPossession(publicKeyHex, signatureHex))
+	})
+
+	t.Run("with bad input", func(t *testing.T) {
+		require.Equal(t, "", doComputeProofOfPossessionAsHex("7cff99bd671502db7d15bc8abc0c9a804fb925406fbdd50f1e4c17a4cd7742"))
+	})
+}
+
+func TestDoComputeAndVerifyOwnAddressSignature(t *testing.T) {
+	privateKeyHex := "7cff99bd671502db7d15bc8abc0c9a804fb925406fbdd50f1e4c17a4cd774247"
+	publicKeyHex := "e7beaa95b3877f47348df4dd1cb578a4f7cabf7a20bfeefe5cdd263878ff132b765e04fef6f40c93512b666c47ed7719b8902f6c922c04247989b7137e837cc81a62e54712471c97a2ddab75aa9c2f58f813ed4c0fa722bde0ab718bff382208"
+	address := "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	addressHex := "0139472eff6886771a982f3083da5d421f24c29181e63888228dc81ca60d69e1"
+
+	signatureHex := doComputeOwnAddressSignatureAsHex(address, privateKeyHex)
+	require.NotEmpty(t, signatureHex)
+
+	t.Run("with good input", func(t *testing.T) {
+		require.True(t, doVerifyOwnAddressSignature(publicKeyHex, address, signatureHex))
+		require.True(t, doVerifyMessageSignature(publicKeyHex, addressHex, signatureHex))
+	})
+
+	t.Run("with another address", func(t *testing.T) {
+		otherAddress := "erd1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqzu66jx"
+		require.False(t, doVerifyOwnAddressSignature(publicKeyHex, otherAddress, signatureHex))
+	})
+
+	t.Run("with bad address", func(t *testing.T) {
+		require.Equal(t, "", doComputeOwnAddressSignatureAsHex("erd1invalid", privateKeyHex))
+		require.False(t, doVerifyOwnAddressSignature(publicKeyHex, "erd1invalid", signatureHex))
+	})
+}
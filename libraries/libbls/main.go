@@ -26,11 +26,7 @@ func verifyMessageSignature(publicKey *C.char, message *C.char, signature *C.cha
 	messageHex := C.GoString(message)
 	signatureHex := C.GoString(signature)
 	ok := doVerifyMessageSignature(publicKeyHex, messageHex, signatureHex)
-	if ok {
-		return 1
-	}
-
-	return 0
+	return boolToInt(ok)
 }
 
 //export generatePrivateKey
@@ -38,3 +34,66 @@ func generatePrivateKey() *C.char {
 	privateKeyHex := doGeneratePrivateKeyAsHex()
 	return C.CString(privateKeyHex)
 }
+
+//export aggregatePublicKeys
+func aggregatePublicKeys(publicKeys *C.char) *C.char {
+	publicKeysHex := C.GoString(publicKeys)
+	aggregatedPublicKeyHex := doAggregatePublicKeysAsHex(publicKeysHex)
+	return C.CString(aggregatedPublicKeyHex)
+}
+
+//export aggregateSignatures
+func aggregateSignatures(signatures *C.char) *C.char {
+	signaturesHex := C.GoString(signatures)
+	aggregatedSignatureHex := doAggregateSignaturesAsHex(signaturesHex)
+	return C.CString(aggregatedSignatureHex)
+}
+
+//export verifyAggregatedSignature
+func verifyAggregatedSignature(publicKeys *C.char, message *C.char, aggregatedSignature *C.char) int {
+	publicKeysHex := C.GoString(publicKeys)
+	messageHex := C.GoString(message)
+	aggregatedSignatureHex := C.GoString(aggregatedSignature)
+	ok := doVerifyAggregatedSignature(publicKeysHex, messageHex, aggregatedSignatureHex)
+	return boolToInt(ok)
+}
+
+//export computeProofOfPossession
+func computeProofOfPossession(privateKey *C.char) *C.char {
+	privateKeyHex := C.GoString(privateKey)
+	proofHex := doComputeProofOfPossessionAsHex(privateKeyHex)
+	return C.CString(proofHex)
+}
+
+//export verifyProofOfPossession
+func verifyProofOfPossession(publicKey *C.char, proof *C.char) int {
+	publicKeyHex := C.GoString(publicKey)
+	proofHex := C.GoString(proof)
+	ok := doVerifyProofOfPossession(publicKeyHex, proofHex)
+	return boolToInt(ok)
+}
+
+//export computeOwnAddressSignature
+func computeOwnAddressSignature(address *C.char, privateKey *C.char) *C.char {
+	addressBech32 := C.GoString(address)
+	privateKeyHex := C.GoString(privateKey)
+	signatureHex := doComputeOwnAddressSignatureAsHex(addressBech32, privateKeyHex)
+	return C.CString(signatureHex)
+}
+
+//export verifyOwnAddressSignature
+func verifyOwnAddressSignature(publicKey *C.char, address *C.char, signature *C.char) int {
+	publicKeyHex := C.GoString(publicKey)
+	addressBech32 := C.GoString(address)
+	signatureHex := C.GoString(signature)
+	ok := doVerifyOwnAddressSignature(publicKeyHex, addressBech32, signatureHex)
+	return boolToInt(ok)
+}
+
+func boolToInt(value bool) int {
+	if value {
+		return 1
+	}
+
+	return 0
+}
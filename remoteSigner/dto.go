@@ -0,0 +1,44 @@
+package remoteSigner
+
+import (
+	"encoding/json"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+const (
+	addressEndpoint         = "signer/address"
+	signTransactionEndpoint = "signer/sign-transaction"
+	signMessageEndpoint     = "signer/sign-message"
+
+	responseCodeSuccessful = "successful"
+	responseCodeBadRequest = "bad_request"
+)
+
+// AddressResponse is the remote signer response holding the address of the managed key
+type AddressResponse struct {
+	Address string `json:"address"`
+}
+
+// SignTransactionRequest is the request used to ask for the signature of a transaction. The remote signer computes
+// the data for signing itself, so it signs exactly the transaction its policy was checked against
+type SignTransactionRequest struct {
+	Transaction *transaction.FrontendTransaction `json:"transaction"`
+}
+
+// SignMessageRequest is the request used to ask for the signature of a hex encoded message
+type SignMessageRequest struct {
+	Message string `json:"message"`
+}
+
+// SignatureResponse is the remote signer response holding the hex encoded signature
+type SignatureResponse struct {
+	Signature string `json:"signature"`
+}
+
+// remoteSignerResponse is the generic remote signer response
+type remoteSignerResponse struct {
+	Data  json.RawMessage `json:"data"`
+	Error string          `json:"error"`
+	Code  string          `json:"code"`
+}
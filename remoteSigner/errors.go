@@ -0,0 +1,52 @@
+package remoteSigner
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+)
+
+// ErrNilHttpClientWrapper signals that a nil http client wrapper was provided
+var ErrNilHttpClientWrapper = errors.New("nil http client wrapper")
+
+// ErrNilTransactionSigner signals that a nil transaction signer was provided
+var ErrNilTransactionSigner = errors.New("nil transaction signer")
+
+// ErrNilDataForSigningComputer signals that a nil data for signing computer was provided
+var ErrNilDataForSigningComputer = errors.New("nil data for signing computer")
+
+// ErrNilTransaction signals that a nil transaction was provided
+var ErrNilTransaction = errors.New("nil transaction")
+
+// ErrSignerNotInvolved signals that the remote signer's address is not the sender, the guardian or the relayer of the transaction
+var ErrSignerNotInvolved = errors.New("the signer is not the sender, the guardian or the relayer of the transaction")
+
+// ErrChainIDNotAllowed signals that the signing policy does not allow the transaction's chain ID
+var ErrChainIDNotAllowed = errors.New("chain ID not allowed")
+
+// ErrReceiverNotAllowed signals that the signing policy does not allow one of the transaction's receivers
+var ErrReceiverNotAllowed = errors.New("receiver not allowed")
+
+// ErrValueCapExceeded signals that the transaction's value exceeds the signing policy's value cap
+var ErrValueCapExceeded = errors.New("value cap exceeded")
+
+// ErrMessageSigningNotAllowed signals that the signing policy does not allow signing messages
+var ErrMessageSigningNotAllowed = errors.New("message signing not allowed")
+
+// ErrInvalidValue signals that an invalid value was provided
+var ErrInvalidValue = errors.New("invalid value")
+
+// ErrInvalidRemoteSignature signals that the signature returned by the remote signer is not valid
+var ErrInvalidRemoteSignature = errors.New("invalid remote signature")
+
+// ErrHTTPStatusCodeIsNotOK signals that the returned HTTP status code is not OK
+var ErrHTTPStatusCodeIsNotOK = errors.New("HTTP status code is not OK")
+
+func createHTTPStatusError(httpStatusCode int, err error) error {
+	if err == nil {
+		err = ErrHTTPStatusCodeIsNotOK
+	}
+
+	return fmt.Errorf("%w, returned http status: %d, %s",
+		err, httpStatusCode, http.StatusText(httpStatusCode))
+}
@@ -0,0 +1,26 @@
+package remoteSigner
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+)
+
+// HttpClientWrapper defines the behavior of http client able to make http requests
+type HttpClientWrapper interface {
+	GetHTTP(ctx context.Context, endpoint string) ([]byte, int, error)
+	PostHTTP(ctx context.Context, endpoint string, data []byte) ([]byte, int, error)
+	IsInterfaceNil() bool
+}
+
+// DataForSigningComputer defines the component able to compute the bytes signed for a transaction
+type DataForSigningComputer interface {
+	ComputeDataForSigning(tx *transaction.FrontendTransaction) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+type signatureVerifier interface {
+	VerifyMessage(msg []byte, publicKey crypto.PublicKey, sig []byte) error
+	VerifyByteSlice(msg []byte, publicKey crypto.PublicKey, sig []byte) error
+}
@@ -0,0 +1,109 @@
+package remoteSigner
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/builders"
+)
+
+// egldTokenIdentifier is the identifier used for the EGLD transfers inside the MultiESDTNFTTransfer built-in function
+const egldTokenIdentifier = "EGLD-000000"
+
+// SigningPolicy holds the rules checked by the remote signer before signing. The empty fields do not restrict anything
+type SigningPolicy struct {
+	// AllowedChainIDs restricts the chains the transactions are signed for
+	AllowedChainIDs []string
+	// AllowedReceivers restricts the bech32 receivers of the transactions. The receivers of the token transfers and
+	// of the relayed inner transactions are checked as well
+	AllowedReceivers []string
+	// MaxValue caps the EGLD amount of the transactions: the value plus the EGLD-000000 transfers of the
+	// MultiESDTNFTTransfer data field, checked for the relayed inner transactions as well. The ESDT amounts are not capped
+	MaxValue *big.Int
+	// AllowMessages allows signing arbitrary messages, such as the native authentication tokens
+	AllowMessages bool
+}
+
+func (policy *SigningPolicy) checkMessage() error {
+	if !policy.AllowMessages {
+		return ErrMessageSigningNotAllowed
+	}
+
+	return nil
+}
+
+func (policy *SigningPolicy) checkTransaction(tx *transaction.FrontendTransaction) error {
+	if len(policy.AllowedChainIDs) > 0 && !contains(policy.AllowedChainIDs, tx.ChainID) {
+		return fmt.Errorf("%w: %s", ErrChainIDNotAllowed, tx.ChainID)
+	}
+
+	if len(policy.AllowedReceivers) == 0 && policy.MaxValue == nil {
+		return nil
+	}
+
+	parsed, err := builders.NewTxDataParser().Parse(tx.Data)
+	if err != nil {
+		return fmt.Errorf("%w: %s", ErrReceiverNotAllowed, err.Error())
+	}
+	err = policy.checkValue(tx.Value, parsed)
+	if err != nil {
+		return err
+	}
+
+	receivers := []string{tx.Receiver}
+	if len(parsed.TransferReceiver) > 0 {
+		receivers = append(receivers, parsed.TransferReceiver)
+	}
+	if parsed.InnerTransaction != nil {
+		receivers = append(receivers, parsed.InnerTransaction.Receiver)
+
+		err = policy.checkValue(parsed.InnerTransaction.Value, parsed.InnerTransactionData)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(policy.AllowedReceivers) == 0 {
+		return nil
+	}
+	for _, receiver := range receivers {
+		if !contains(policy.AllowedReceivers, receiver) {
+			return fmt.Errorf("%w: %s", ErrReceiverNotAllowed, receiver)
+		}
+	}
+
+	return nil
+}
+
+// checkValue caps the value together with the EGLD-000000 transfers of the parsed data field
+func (policy *SigningPolicy) checkValue(value string, parsed *builders.ParsedTxData) error {
+	if policy.MaxValue == nil {
+		return nil
+	}
+
+	valueBI, ok := big.NewInt(0).SetString(value, 10)
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrInvalidValue, value)
+	}
+	for _, transfer := range parsed.TokenTransfers {
+		if transfer.TokenIdentifier == egldTokenIdentifier && transfer.Amount != nil {
+			valueBI.Add(valueBI, transfer.Amount)
+		}
+	}
+	if valueBI.Cmp(policy.MaxValue) > 0 {
+		return fmt.Errorf("%w: value %s, maximum is %s", ErrValueCapExceeded, valueBI.String(), policy.MaxValue.String())
+	}
+
+	return nil
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+
+	return false
+}
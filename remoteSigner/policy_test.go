@@ -0,0 +1,107 @@
+package remoteSigner
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testAllowedReceiver = "erd1qyu5wthldzr8wx5c9ucg8kjagg0jfs53s8nr3zpz3hypefsdd8ssycr6th"
+	testOtherReceiver   = "erd1spyavw0956vq68xj8y4tenjpq2wd5a9p2c6j8gsz7ztyrnpxrruqzu66jx"
+)
+
+func TestSigningPolicy_checkTransaction(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty policy should allow everything", func(t *testing.T) {
+		t.Parallel()
+
+		policy := &SigningPolicy{}
+		tx := createTestTransaction(testOtherReceiver)
+		tx.Value = "100000000000000000000000"
+		assert.Nil(t, policy.checkTransaction(tx))
+		assert.Equal(t, ErrMessageSigningNotAllowed, policy.checkMessage())
+	})
+	t.Run("invalid value should error", func(t *testing.T) {
+		t.Parallel()
+
+		policy := &SigningPolicy{MaxValue: big.NewInt(10)}
+		tx := createTestTransaction(testAllowedReceiver)
+		tx.Value = "ten"
+		assert.True(t, errors.Is(policy.checkTransaction(tx), ErrInvalidValue))
+	})
+	t.Run("token transfer to a not allowed receiver should error", func(t *testing.T) {
+		t.Parallel()
+
+		policy := &SigningPolicy{AllowedReceivers: []string{testAllowedReceiver}}
+		receiver, _ := data.NewAddressFromBech32String(testOtherReceiver)
+		txData, _ := builders.NewTxDataBuilder().
+			Function("ESDTNFTTransfer").
+			ArgBytes([]byte("NFT-123456")).
+			ArgInt64(1).
+			ArgInt64(1).
+			ArgAddress(receiver).
+			ToDataBytes()
+
+		tx := createTestTransaction(testAllowedReceiver)
+		tx.Data = txData
+		assert.True(t, errors.Is(policy.checkTransaction(tx), ErrReceiverNotAllowed))
+
+		policy.AllowedReceivers = append(policy.AllowedReceivers, testOtherReceiver)
+		assert.Nil(t, policy.checkTransaction(tx))
+	})
+	t.Run("plain text note should be allowed", func(t *testing.T) {
+		t.Parallel()
+
+		policy := &SigningPolicy{AllowedReceivers: []string{testAllowedReceiver}, MaxValue: big.NewInt(1000)}
+		tx := createTestTransaction(testAllowedReceiver)
+		tx.Value = "10"
+		tx.Data = []byte("thanks @bob")
+		assert.Nil(t, policy.checkTransaction(tx))
+	})
+	t.Run("EGLD multi transfer over the value cap should error", func(t *testing.T) {
+		t.Parallel()
+
+		policy := &SigningPolicy{MaxValue: big.NewInt(1000)}
+		receiver, _ := data.NewAddressFromBech32String(testAllowedReceiver)
+		txData, _ := builders.NewTxDataBuilder().
+			Function("MultiESDTNFTTransfer").
+			ArgAddress(receiver).
+			ArgInt64(2).
+			ArgBytes([]byte("EGLD-000000")).
+			ArgInt64(0).
+			ArgInt64(600).
+			ArgBytes([]byte("TKN-123456")).
+			ArgInt64(0).
+			ArgInt64(5000).
+			ToDataBytes()
+
+		tx := createTestTransaction(testAllowedReceiver)
+		tx.Value = "500"
+		tx.Data = txData
+		assert.True(t, errors.Is(policy.checkTransaction(tx), ErrValueCapExceeded))
+
+		tx.Value = "0"
+		assert.Nil(t, policy.checkTransaction(tx))
+	})
+	t.Run("relayed inner transaction over the value cap should error", func(t *testing.T) {
+		t.Parallel()
+
+		policy := &SigningPolicy{MaxValue: big.NewInt(1000)}
+		innerTx := `{"nonce":1,"value":2000,"receiver":"AQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQEBAQE=","sender":"AgICAgICAgICAgICAgICAgICAgICAgICAgICAgICAgI=","gasPrice":1000000000,"gasLimit":50000,"chainID":"VA==","version":2}`
+		txData, _ := builders.NewTxDataBuilder().
+			Function("relayedTx").
+			ArgBytes([]byte(innerTx)).
+			ToDataBytes()
+
+		tx := createTestTransaction(testAllowedReceiver)
+		tx.Value = "0"
+		tx.Data = txData
+		assert.True(t, errors.Is(policy.checkTransaction(tx), ErrValueCapExceeded))
+	})
+}
@@ -0,0 +1,184 @@
+package remoteSigner
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+const defaultRequestTimeout = time.Second * 10
+
+var keyGenerator = signing.NewKeyGenerator(ed25519.NewEd25519())
+
+// ArgsRemoteSignerClient is the DTO used in the remote signer client constructor
+type ArgsRemoteSignerClient struct {
+	HttpClientWrapper HttpClientWrapper
+	// Address is the bech32 address of the key held by the remote signer. The returned signatures are verified against it
+	Address string
+	// RequestTimeout is optional, the default is 10 seconds
+	RequestTimeout time.Duration
+	// AddressConverter is optional, when not provided the default erd prefixed address converter is used
+	AddressConverter core.AddressConverter
+}
+
+// remoteSignerClient is a transaction signer that delegates the signing to a remote signer server
+type remoteSignerClient struct {
+	httpClientWrapper HttpClientWrapper
+	address           core.AddressHandler
+	bech32            string
+	publicKey         crypto.PublicKey
+	verifier          signatureVerifier
+	requestTimeout    time.Duration
+}
+
+// NewRemoteSignerClient creates a new client for a remote signer server
+func NewRemoteSignerClient(args ArgsRemoteSignerClient) (*remoteSignerClient, error) {
+	if check.IfNil(args.HttpClientWrapper) {
+		return nil, ErrNilHttpClientWrapper
+	}
+
+	address, err := data.NewAddressFromBech32StringWithConverter(args.Address, args.AddressConverter)
+	if err != nil {
+		return nil, fmt.Errorf("%w for the remote signer address %q", err, args.Address)
+	}
+	publicKey, err := keyGenerator.PublicKeyFromByteArray(address.AddressBytes())
+	if err != nil {
+		return nil, err
+	}
+
+	requestTimeout := args.RequestTimeout
+	if requestTimeout == 0 {
+		requestTimeout = defaultRequestTimeout
+	}
+
+	return &remoteSignerClient{
+		httpClientWrapper: args.HttpClientWrapper,
+		address:           address,
+		bech32:            args.Address,
+		publicKey:         publicKey,
+		verifier:          cryptoProvider.NewSigner(),
+		requestTimeout:    requestTimeout,
+	}, nil
+}
+
+// GetBech32 returns the bech32 address of the remote key
+func (client *remoteSignerClient) GetBech32() string {
+	return client.bech32
+}
+
+// GetAddressHandler returns the address of the remote key
+func (client *remoteSignerClient) GetAddressHandler() core.AddressHandler {
+	return client.address
+}
+
+// GetRemoteAddress returns the address of the key held by the remote signer, useful to check the configuration
+func (client *remoteSignerClient) GetRemoteAddress(ctx context.Context) (string, error) {
+	buff, code, err := client.httpClientWrapper.GetHTTP(ctx, addressEndpoint)
+	if err != nil {
+		return "", err
+	}
+
+	response := &AddressResponse{}
+	err = parseResponse(buff, code, response)
+	if err != nil {
+		return "", err
+	}
+
+	return response.Address, nil
+}
+
+// SignTransaction asks the remote signer to sign the transaction and checks that the returned signature is valid
+// for the provided data for signing
+func (client *remoteSignerClient) SignTransaction(tx *transaction.FrontendTransaction, dataForSigning []byte) ([]byte, error) {
+	if tx == nil {
+		return nil, ErrNilTransaction
+	}
+
+	signature, err := client.requestSignature(signTransactionEndpoint, &SignTransactionRequest{Transaction: tx})
+	if err != nil {
+		return nil, err
+	}
+
+	err = client.verifier.VerifyByteSlice(dataForSigning, client.publicKey, signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRemoteSignature, err.Error())
+	}
+
+	return signature, nil
+}
+
+// SignMessage asks the remote signer to sign the message and checks that the returned signature is valid
+func (client *remoteSignerClient) SignMessage(message []byte) ([]byte, error) {
+	signature, err := client.requestSignature(signMessageEndpoint, &SignMessageRequest{Message: hex.EncodeToString(message)})
+	if err != nil {
+		return nil, err
+	}
+
+	err = client.verifier.VerifyMessage(message, client.publicKey, signature)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidRemoteSignature, err.Error())
+	}
+
+	return signature, nil
+}
+
+func (client *remoteSignerClient) requestSignature(endpoint string, request interface{}) ([]byte, error) {
+	requestBytes, err := json.Marshal(request)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), client.requestTimeout)
+	defer cancel()
+
+	buff, code, err := client.httpClientWrapper.PostHTTP(ctx, endpoint, requestBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	response := &SignatureResponse{}
+	err = parseResponse(buff, code, response)
+	if err != nil {
+		return nil, err
+	}
+
+	return hex.DecodeString(response.Signature)
+}
+
+func parseResponse(buff []byte, code int, responseData interface{}) error {
+	response := &remoteSignerResponse{}
+	errUnmarshal := json.Unmarshal(buff, response)
+	if code != http.StatusOK {
+		if errUnmarshal == nil && len(response.Error) > 0 {
+			return createHTTPStatusError(code, errors.New(response.Error))
+		}
+
+		return createHTTPStatusError(code, errUnmarshal)
+	}
+	if errUnmarshal != nil {
+		return errUnmarshal
+	}
+	if len(response.Error) > 0 {
+		return errors.New(response.Error)
+	}
+
+	return json.Unmarshal(response.Data, responseData)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (client *remoteSignerClient) IsInterfaceNil() bool {
+	return client == nil
+}
@@ -0,0 +1,188 @@
+package remoteSigner
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+// ArgsRemoteSignerServer is the DTO used in the reference remote signer server constructor
+type ArgsRemoteSignerServer struct {
+	TxSigner     core.TransactionSigner
+	DataComputer DataForSigningComputer
+	Policy       SigningPolicy
+}
+
+// remoteSignerServer is a reference implementation of a signing service. It holds a single key, behind any
+// transaction signer, and signs the transactions and the messages allowed by its policy. It can be served with any
+// http server, for example http.ListenAndServe on a local address or httptest.NewServer
+type remoteSignerServer struct {
+	txSigner     core.TransactionSigner
+	dataComputer DataForSigningComputer
+	policy       SigningPolicy
+	mux          *http.ServeMux
+}
+
+// NewRemoteSignerServer creates a new reference remote signer server
+func NewRemoteSignerServer(args ArgsRemoteSignerServer) (*remoteSignerServer, error) {
+	if check.IfNil(args.TxSigner) {
+		return nil, ErrNilTransactionSigner
+	}
+	if check.IfNil(args.DataComputer) {
+		return nil, ErrNilDataForSigningComputer
+	}
+
+	server := &remoteSignerServer{
+		txSigner:     args.TxSigner,
+		dataComputer: args.DataComputer,
+		policy:       args.Policy,
+		mux:          http.NewServeMux(),
+	}
+	server.mux.HandleFunc("/"+addressEndpoint, server.handleAddress)
+	server.mux.HandleFunc("/"+signTransactionEndpoint, server.handleSignTransaction)
+	server.mux.HandleFunc("/"+signMessageEndpoint, server.handleSignMessage)
+
+	return server, nil
+}
+
+// ServeHTTP handles the remote signer requests
+func (server *remoteSignerServer) ServeHTTP(writer http.ResponseWriter, request *http.Request) {
+	server.mux.ServeHTTP(writer, request)
+}
+
+func (server *remoteSignerServer) handleAddress(writer http.ResponseWriter, request *http.Request) {
+	if request.Method != http.MethodGet {
+		writeError(writer, fmt.Errorf("method %s not allowed", request.Method))
+		return
+	}
+
+	writeResponse(writer, &AddressResponse{
+		Address: server.txSigner.GetBech32(),
+	})
+}
+
+func (server *remoteSignerServer) handleSignTransaction(writer http.ResponseWriter, request *http.Request) {
+	signRequest := &SignTransactionRequest{}
+	if !decodeRequest(writer, request, signRequest) {
+		return
+	}
+
+	tx := signRequest.Transaction
+	if tx == nil {
+		writeError(writer, ErrNilTransaction)
+		return
+	}
+	if !server.isInvolved(tx) {
+		writeError(writer, ErrSignerNotInvolved)
+		return
+	}
+
+	err := server.policy.checkTransaction(tx)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+
+	dataForSigning, err := server.dataComputer.ComputeDataForSigning(tx)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+
+	signature, err := server.txSigner.SignTransaction(tx, dataForSigning)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+
+	writeResponse(writer, &SignatureResponse{
+		Signature: hex.EncodeToString(signature),
+	})
+}
+
+func (server *remoteSignerServer) isInvolved(tx *transaction.FrontendTransaction) bool {
+	address := server.txSigner.GetBech32()
+
+	return tx.Sender == address || tx.GuardianAddr == address || tx.RelayerAddr == address
+}
+
+func (server *remoteSignerServer) handleSignMessage(writer http.ResponseWriter, request *http.Request) {
+	signRequest := &SignMessageRequest{}
+	if !decodeRequest(writer, request, signRequest) {
+		return
+	}
+
+	err := server.policy.checkMessage()
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+
+	message, err := hex.DecodeString(signRequest.Message)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+
+	signature, err := server.txSigner.SignMessage(message)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+
+	writeResponse(writer, &SignatureResponse{
+		Signature: hex.EncodeToString(signature),
+	})
+}
+
+func decodeRequest(writer http.ResponseWriter, request *http.Request, obj interface{}) bool {
+	if request.Method != http.MethodPost {
+		writeError(writer, fmt.Errorf("method %s not allowed", request.Method))
+		return false
+	}
+
+	err := json.NewDecoder(request.Body).Decode(obj)
+	if err != nil {
+		writeError(writer, err)
+		return false
+	}
+
+	return true
+}
+
+func writeResponse(writer http.ResponseWriter, responseData interface{}) {
+	dataBytes, err := json.Marshal(responseData)
+	if err != nil {
+		writeError(writer, err)
+		return
+	}
+
+	writeJSON(writer, http.StatusOK, &remoteSignerResponse{
+		Data: dataBytes,
+		Code: responseCodeSuccessful,
+	})
+}
+
+func writeError(writer http.ResponseWriter, err error) {
+	writeJSON(writer, http.StatusBadRequest, &remoteSignerResponse{
+		Error: strings.TrimSpace(err.Error()),
+		Code:  responseCodeBadRequest,
+	})
+}
+
+func writeJSON(writer http.ResponseWriter, statusCode int, response *remoteSignerResponse) {
+	writer.Header().Set("Content-Type", "application/json")
+	writer.WriteHeader(statusCode)
+	_ = json.NewEncoder(writer).Encode(response)
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (server *remoteSignerServer) IsInterfaceNil() bool {
+	return server == nil
+}
@@ -0,0 +1,255 @@
+package remoteSigner
+
+import (
+	"context"
+	"encoding/hex"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/core"
+	sdkHttp "github.com/multiversx/mx-sdk-go/core/http"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testRemoteKeyHex = "6ae10fed53a84029e53e35afdbe083688eea0917a09a9431951dd42fd4da14c4"
+	testOtherKeyHex  = "28654d9264f55f18d810bb88617e22c117df94fa684dfe341a511a72dfbf2b68"
+)
+
+func createCryptoHolder(t *testing.T, hexSk string) core.CryptoComponentsHolder {
+	sk, err := hex.DecodeString(hexSk)
+	require.Nil(t, err)
+
+	holder, err := cryptoProvider.NewCryptoComponentsHolder(keyGenerator, sk)
+	require.Nil(t, err)
+
+	return holder
+}
+
+func startRemoteSigner(t *testing.T, holder core.CryptoComponentsHolder, policy SigningPolicy) *httptest.Server {
+	signer := cryptoProvider.NewSigner()
+	txBuilder, err := builders.NewTxBuilder(signer)
+	require.Nil(t, err)
+	holderSigner, err := builders.NewCryptoHolderTransactionSigner(signer, holder)
+	require.Nil(t, err)
+
+	server, err := NewRemoteSignerServer(ArgsRemoteSignerServer{
+		TxSigner:     holderSigner,
+		DataComputer: txBuilder,
+		Policy:       policy,
+	})
+	require.Nil(t, err)
+
+	httpServer := httptest.NewServer(server)
+	t.Cleanup(httpServer.Close)
+
+	return httpServer
+}
+
+func createTestTransaction(receiver string) *transaction.FrontendTransaction {
+	return &transaction.FrontendTransaction{
+		Nonce:    1,
+		Value:    "1000",
+		Receiver: receiver,
+		GasPrice: 1000000000,
+		GasLimit: 50000,
+		ChainID:  "T",
+		Version:  2,
+	}
+}
+
+func TestNewRemoteSignerServer(t *testing.T) {
+	t.Parallel()
+
+	txBuilder, _ := builders.NewTxBuilder(cryptoProvider.NewSigner())
+	holderSigner, _ := builders.NewCryptoHolderTransactionSigner(cryptoProvider.NewSigner(), createCryptoHolder(t, testRemoteKeyHex))
+
+	server, err := NewRemoteSignerServer(ArgsRemoteSignerServer{DataComputer: txBuilder})
+	assert.True(t, check.IfNil(server))
+	assert.Equal(t, ErrNilTransactionSigner, err)
+
+	server, err = NewRemoteSignerServer(ArgsRemoteSignerServer{TxSigner: holderSigner})
+	assert.True(t, check.IfNil(server))
+	assert.Equal(t, ErrNilDataForSigningComputer, err)
+
+	server, err = NewRemoteSignerServer(ArgsRemoteSignerServer{TxSigner: holderSigner, DataComputer: txBuilder})
+	assert.False(t, check.IfNil(server))
+	assert.Nil(t, err)
+}
+
+func TestNewRemoteSignerClient(t *testing.T) {
+	t.Parallel()
+
+	client, err := NewRemoteSignerClient(ArgsRemoteSignerClient{Address: createCryptoHolder(t, testRemoteKeyHex).GetBech32()})
+	assert.True(t, check.IfNil(client))
+	assert.Equal(t, ErrNilHttpClientWrapper, err)
+
+	client, err = NewRemoteSignerClient(ArgsRemoteSignerClient{
+		HttpClientWrapper: sdkHttp.NewHttpClientWrapper(nil, "http://localhost"),
+		Address:           "invalid",
+	})
+	assert.True(t, check.IfNil(client))
+	assert.NotNil(t, err)
+
+	client, err = NewRemoteSignerClient(ArgsRemoteSignerClient{
+		HttpClientWrapper: sdkHttp.NewHttpClientWrapper(nil, "http://localhost"),
+		Address:           createCryptoHolder(t, testRemoteKeyHex).GetBech32(),
+	})
+	assert.False(t, check.IfNil(client))
+	assert.Nil(t, err)
+}
+
+func TestNewRemoteSignerClient_WithAddressConverter(t *testing.T) {
+	t.Parallel()
+
+	converter, err := core.NewAddressPublicKeyConverter("sov")
+	require.Nil(t, err)
+	publicKey, _ := createCryptoHolder(t, testRemoteKeyHex).GetPublicKey().ToByteArray()
+	address, err := converter.Encode(publicKey)
+	require.Nil(t, err)
+
+	client, err := NewRemoteSignerClient(ArgsRemoteSignerClient{
+		HttpClientWrapper: sdkHttp.NewHttpClientWrapper(nil, "http://localhost"),
+		Address:           address,
+	})
+	assert.True(t, check.IfNil(client))
+	assert.NotNil(t, err)
+
+	client, err = NewRemoteSignerClient(ArgsRemoteSignerClient{
+		HttpClientWrapper: sdkHttp.NewHttpClientWrapper(nil, "http://localhost"),
+		Address:           address,
+		AddressConverter:  converter,
+	})
+	require.Nil(t, err)
+	assert.Equal(t, address, client.GetBech32())
+
+	bech32, err := client.GetAddressHandler().AddressAsBech32String()
+	require.Nil(t, err)
+	assert.Equal(t, address, bech32)
+}
+
+func TestRemoteSignerClientAndServer_SigningFlow(t *testing.T) {
+	t.Parallel()
+
+	holder := createCryptoHolder(t, testRemoteKeyHex)
+	other := createCryptoHolder(t, testOtherKeyHex)
+	signer := cryptoProvider.NewSigner()
+	txBuilder, _ := builders.NewTxBuilder(signer)
+
+	httpServer := startRemoteSigner(t, holder, SigningPolicy{
+		AllowedChainIDs:  []string{"T"},
+		AllowedReceivers: []string{other.GetBech32()},
+		MaxValue:         big.NewInt(1000),
+		AllowMessages:    true,
+	})
+	client, err := NewRemoteSignerClient(ArgsRemoteSignerClient{
+		HttpClientWrapper: sdkHttp.NewHttpClientWrapper(nil, httpServer.URL),
+		Address:           holder.GetBech32(),
+	})
+	require.Nil(t, err)
+
+	t.Run("remote address should work", func(t *testing.T) {
+		address, errGet := client.GetRemoteAddress(context.Background())
+		assert.Nil(t, errGet)
+		assert.Equal(t, holder.GetBech32(), address)
+	})
+	t.Run("user signature should be the same as the local one", func(t *testing.T) {
+		tx := createTestTransaction(other.GetBech32())
+		errSign := txBuilder.ApplyUserSignatureWithSigner(client, tx)
+		require.Nil(t, errSign)
+
+		localTx := createTestTransaction(other.GetBech32())
+		_ = txBuilder.ApplyUserSignature(holder, localTx)
+		assert.Equal(t, holder.GetBech32(), tx.Sender)
+		assert.Equal(t, localTx.Signature, tx.Signature)
+	})
+	t.Run("guardian signature should work", func(t *testing.T) {
+		tx := createTestTransaction(other.GetBech32())
+		tx.GuardianAddr = holder.GetBech32()
+		tx.Options = transaction.MaskGuardedTransaction
+		_ = txBuilder.ApplyUserSignature(other, tx)
+
+		errSign := txBuilder.ApplyGuardianSignatureWithSigner(client, tx)
+		require.Nil(t, errSign)
+		assert.NotEmpty(t, tx.GuardianSignature)
+	})
+	t.Run("message signature should be the same as the local one", func(t *testing.T) {
+		signature, errSign := client.SignMessage([]byte("message"))
+		require.Nil(t, errSign)
+
+		localSignature, _ := signer.SignMessage([]byte("message"), holder.GetPrivateKey())
+		assert.Equal(t, localSignature, signature)
+	})
+	t.Run("not involved signer should error", func(t *testing.T) {
+		tx := createTestTransaction(other.GetBech32())
+		tx.Sender = other.GetBech32()
+
+		_, errSign := client.SignTransaction(tx, []byte("data"))
+		assert.ErrorContains(t, errSign, ErrSignerNotInvolved.Error())
+	})
+	t.Run("chain ID not allowed should error", func(t *testing.T) {
+		tx := createTestTransaction(other.GetBech32())
+		tx.ChainID = "1"
+
+		errSign := txBuilder.ApplyUserSignatureWithSigner(client, tx)
+		assert.ErrorContains(t, errSign, ErrChainIDNotAllowed.Error())
+		assert.Empty(t, tx.Signature)
+	})
+	t.Run("receiver not allowed should error", func(t *testing.T) {
+		tx := createTestTransaction(holder.GetBech32())
+
+		errSign := txBuilder.ApplyUserSignatureWithSigner(client, tx)
+		assert.ErrorContains(t, errSign, ErrReceiverNotAllowed.Error())
+	})
+	t.Run("value cap exceeded should error", func(t *testing.T) {
+		tx := createTestTransaction(other.GetBech32())
+		tx.Value = "1001"
+
+		errSign := txBuilder.ApplyUserSignatureWithSigner(client, tx)
+		assert.ErrorContains(t, errSign, ErrValueCapExceeded.Error())
+	})
+	t.Run("signature of other data should error", func(t *testing.T) {
+		tx := createTestTransaction(other.GetBech32())
+		tx.Sender = holder.GetBech32()
+
+		_, errSign := client.SignTransaction(tx, []byte("other data"))
+		assert.ErrorIs(t, errSign, ErrInvalidRemoteSignature)
+	})
+}
+
+func TestRemoteSignerClientAndServer_MessagesNotAllowed(t *testing.T) {
+	t.Parallel()
+
+	holder := createCryptoHolder(t, testRemoteKeyHex)
+	httpServer := startRemoteSigner(t, holder, SigningPolicy{})
+	client, _ := NewRemoteSignerClient(ArgsRemoteSignerClient{
+		HttpClientWrapper: sdkHttp.NewHttpClientWrapper(nil, httpServer.URL),
+		Address:           holder.GetBech32(),
+	})
+
+	signature, err := client.SignMessage([]byte("message"))
+	assert.Nil(t, signature)
+	assert.ErrorContains(t, err, ErrMessageSigningNotAllowed.Error())
+}
+
+func TestRemoteSignerClient_WrongRemoteKeyShouldError(t *testing.T) {
+	t.Parallel()
+
+	holder := createCryptoHolder(t, testRemoteKeyHex)
+	other := createCryptoHolder(t, testOtherKeyHex)
+	httpServer := startRemoteSigner(t, other, SigningPolicy{AllowMessages: true})
+	client, _ := NewRemoteSignerClient(ArgsRemoteSignerClient{
+		HttpClientWrapper: sdkHttp.NewHttpClientWrapper(nil, httpServer.URL),
+		Address:           holder.GetBech32(),
+	})
+
+	signature, err := client.SignMessage([]byte("message"))
+	assert.Nil(t, signature)
+	assert.ErrorIs(t, err, ErrInvalidRemoteSignature)
+}
@@ -0,0 +1,22 @@
+package testsCommon
+
+import "github.com/multiversx/mx-sdk-go/core"
+
+// AddressGeneratorStub -
+type AddressGeneratorStub struct {
+	ComputeWasmVMScAddressCalled func(address core.AddressHandler, nonce uint64) (core.AddressHandler, error)
+}
+
+// ComputeWasmVMScAddress -
+func (stub *AddressGeneratorStub) ComputeWasmVMScAddress(address core.AddressHandler, nonce uint64) (core.AddressHandler, error) {
+	if stub.ComputeWasmVMScAddressCalled != nil {
+		return stub.ComputeWasmVMScAddressCalled(address, nonce)
+	}
+
+	return nil, nil
+}
+
+// IsInterfaceNil -
+func (stub *AddressGeneratorStub) IsInterfaceNil() bool {
+	return stub == nil
+}
@@ -0,0 +1,20 @@
+package testsCommon
+
+// ArgumentsEncoderStub -
+type ArgumentsEncoderStub struct {
+	EncodeConstructorArgumentsCalled func(args ...interface{}) ([][]byte, error)
+}
+
+// EncodeConstructorArguments -
+func (stub *ArgumentsEncoderStub) EncodeConstructorArguments(args ...interface{}) ([][]byte, error) {
+	if stub.EncodeConstructorArgumentsCalled != nil {
+		return stub.EncodeConstructorArgumentsCalled(args...)
+	}
+
+	return make([][]byte, 0), nil
+}
+
+// IsInterfaceNil -
+func (stub *ArgumentsEncoderStub) IsInterfaceNil() bool {
+	return stub == nil
+}
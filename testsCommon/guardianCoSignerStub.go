@@ -0,0 +1,26 @@
+package testsCommon
+
+import (
+	"context"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+)
+
+// GuardianCoSignerStub -
+type GuardianCoSignerStub struct {
+	CoSignTransactionCalled func(ctx context.Context, tx *transaction.FrontendTransaction) error
+}
+
+// CoSignTransaction -
+func (stub *GuardianCoSignerStub) CoSignTransaction(ctx context.Context, tx *transaction.FrontendTransaction) error {
+	if stub.CoSignTransactionCalled != nil {
+		return stub.CoSignTransactionCalled(ctx, tx)
+	}
+
+	return nil
+}
+
+// IsInterfaceNil -
+func (stub *GuardianCoSignerStub) IsInterfaceNil() bool {
+	return stub == nil
+}
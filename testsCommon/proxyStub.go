@@ -35,6 +35,7 @@ type ProxyStub struct {
 	GetDefaultTransactionArgumentsCalled func(ctx context.Context, address sdkCore.AddressHandler, networkConfigs *data.NetworkConfig) (transaction.FrontendTransaction, string, error)
 	GetValidatorsInfoByEpochCalled       func(ctx context.Context, epoch uint32) ([]*state.ShardValidatorInfo, error)
 	GetGuardianDataCalled                func(ctx context.Context, address sdkCore.AddressHandler) (*api.GuardianData, error)
+	RequestTransactionCostCalled         func(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error)
 	FilterLogsCalled                     func(ctx context.Context, filter *sdkCore.FilterQuery) ([]*transaction.Events, error)
 }
 
@@ -230,6 +231,15 @@ func (stub *ProxyStub) GetGuardianData(ctx context.Context, address sdkCore.Addr
 	return &api.GuardianData{}, nil
 }
 
+// RequestTransactionCost -
+func (stub *ProxyStub) RequestTransactionCost(ctx context.Context, tx *transaction.FrontendTransaction) (*data.TxCostResponseData, error) {
+	if stub.RequestTransactionCostCalled != nil {
+		return stub.RequestTransactionCostCalled(ctx, tx)
+	}
+
+	return &data.TxCostResponseData{}, nil
+}
+
 // FilterLogs -
 func (stub *ProxyStub) FilterLogs(ctx context.Context, filter *sdkCore.FilterQuery) ([]*transaction.Events, error) {
 	if stub.FilterLogsCalled != nil {
@@ -7,7 +7,10 @@ import (
 
 // TxBuilderStub -
 type TxBuilderStub struct {
-	ApplyUserSignatureCalled func(cryptoHolder sdkCore.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error
+	ApplyUserSignatureCalled           func(cryptoHolder sdkCore.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error
+	ApplyGuardianSignatureCalled       func(cryptoHolderGuardian sdkCore.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error
+	ApplyUserSignatureWithSignerCalled func(txSigner sdkCore.TransactionSigner, tx *transaction.FrontendTransaction) error
+	ComputeDataForSigningCalled        func(tx *transaction.FrontendTransaction) ([]byte, error)
 }
 
 // ApplyUserSignature -
@@ -19,6 +22,33 @@ func (stub *TxBuilderStub) ApplyUserSignature(cryptoHolder sdkCore.CryptoCompone
 	return nil
 }
 
+// ApplyUserSignatureWithSigner -
+func (stub *TxBuilderStub) ApplyUserSignatureWithSigner(txSigner sdkCore.TransactionSigner, tx *transaction.FrontendTransaction) error {
+	if stub.ApplyUserSignatureWithSignerCalled != nil {
+		return stub.ApplyUserSignatureWithSignerCalled(txSigner, tx)
+	}
+
+	return nil
+}
+
+// ApplyGuardianSignature -
+func (stub *TxBuilderStub) ApplyGuardianSignature(cryptoHolderGuardian sdkCore.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error {
+	if stub.ApplyGuardianSignatureCalled != nil {
+		return stub.ApplyGuardianSignatureCalled(cryptoHolderGuardian, tx)
+	}
+
+	return nil
+}
+
+// ComputeDataForSigning -
+func (stub *TxBuilderStub) ComputeDataForSigning(tx *transaction.FrontendTransaction) ([]byte, error) {
+	if stub.ComputeDataForSigningCalled != nil {
+		return stub.ComputeDataForSigningCalled(tx)
+	}
+
+	return make([]byte, 0), nil
+}
+
 // IsInterfaceNil -
 func (stub *TxBuilderStub) IsInterfaceNil() bool {
 	return stub == nil
@@ -0,0 +1,21 @@
+package validatorKeys
+
+import "errors"
+
+// ErrInvalidNumberOfKeys signals that an invalid number of keys was requested
+var ErrInvalidNumberOfKeys = errors.New("invalid number of keys")
+
+// ErrNoValidatorKeys signals that no validator keys were provided
+var ErrNoValidatorKeys = errors.New("no validator keys")
+
+// ErrNilValidatorKey signals that a nil validator key was provided
+var ErrNilValidatorKey = errors.New("nil validator key")
+
+// ErrNilAddressHandler signals that a nil address handler was provided
+var ErrNilAddressHandler = errors.New("nil address handler")
+
+// ErrInvalidPemFile signals that an invalid validator keys .pem file was provided
+var ErrInvalidPemFile = errors.New("invalid validator keys .PEM file")
+
+// ErrPublicKeyMismatch signals that the public key from the .pem label does not match the private key
+var ErrPublicKeyMismatch = errors.New("public key mismatch")
@@ -0,0 +1,245 @@
+package validatorKeys
+
+import (
+	"bytes"
+	"encoding/hex"
+	"encoding/pem"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	crypto "github.com/multiversx/mx-chain-crypto-go"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl"
+	"github.com/multiversx/mx-chain-crypto-go/signing/mcl/singlesig"
+	"github.com/multiversx/mx-sdk-go/builders"
+	"github.com/multiversx/mx-sdk-go/core"
+)
+
+const pemLabelPrefix = "PRIVATE KEY for "
+
+// ValidatorKey is a BLS key pair used by a validator node
+type ValidatorKey struct {
+	PrivateKey []byte
+	PublicKey  []byte
+}
+
+// PublicKeyAsHex returns the hex encoded BLS public key, as it is displayed by the node and used as the .pem label
+func (key *ValidatorKey) PublicKeyAsHex() string {
+	return hex.EncodeToString(key.PublicKey)
+}
+
+type validatorKeysManager struct {
+	keyGenerator crypto.KeyGenerator
+	signer       crypto.SingleSigner
+}
+
+// NewValidatorKeysManager creates a new component able to generate, save and load the validator (BLS) keys and to
+// produce the stake transaction arguments
+func NewValidatorKeysManager() *validatorKeysManager {
+	return &validatorKeysManager{
+		keyGenerator: signing.NewKeyGenerator(mcl.NewSuiteBLS12()),
+		signer:       &singlesig.BlsSingleSigner{},
+	}
+}
+
+// GenerateKey generates a new, random, validator key
+func (manager *validatorKeysManager) GenerateKey() (*ValidatorKey, error) {
+	privateKey, _ := manager.keyGenerator.GeneratePair()
+
+	return manager.createValidatorKey(privateKey)
+}
+
+// GenerateKeys generates the provided number of new, random, validator keys
+func (manager *validatorKeysManager) GenerateKeys(numKeys int) ([]*ValidatorKey, error) {
+	if numKeys < 1 {
+		return nil, fmt.Errorf("%w: %d", ErrInvalidNumberOfKeys, numKeys)
+	}
+
+	keys := make([]*ValidatorKey, 0, numKeys)
+	for i := 0; i < numKeys; i++ {
+		key, err := manager.GenerateKey()
+		if err != nil {
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// ValidatorKeyFromPrivateKey creates the validator key of the provided BLS private key
+func (manager *validatorKeysManager) ValidatorKeyFromPrivateKey(privateKeyBytes []byte) (*ValidatorKey, error) {
+	privateKey, err := manager.keyGenerator.PrivateKeyFromByteArray(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager.createValidatorKey(privateKey)
+}
+
+func (manager *validatorKeysManager) createValidatorKey(privateKey crypto.PrivateKey) (*ValidatorKey, error) {
+	privateKeyBytes, err := privateKey.ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	publicKeyBytes, err := privateKey.GeneratePublic().ToByteArray()
+	if err != nil {
+		return nil, err
+	}
+
+	return &ValidatorKey{
+		PrivateKey: privateKeyBytes,
+		PublicKey:  publicKeyBytes,
+	}, nil
+}
+
+// SaveKeysToPemFile saves the validator keys in a .pem file, in the validatorKey.pem format used by the node: each key
+// is labeled with its hex encoded public key. An existing file is overwritten
+func (manager *validatorKeysManager) SaveKeysToPemFile(filename string, keys ...*ValidatorKey) error {
+	buff, err := manager.EncodeKeysToPem(keys...)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, buff, 0600)
+}
+
+// EncodeKeysToPem returns the .pem contents holding the provided validator keys
+func (manager *validatorKeysManager) EncodeKeysToPem(keys ...*ValidatorKey) ([]byte, error) {
+	if len(keys) == 0 {
+		return nil, ErrNoValidatorKeys
+	}
+
+	buff := bytes.NewBuffer(nil)
+	for _, key := range keys {
+		if key == nil {
+			return nil, ErrNilValidatorKey
+		}
+
+		blk := &pem.Block{
+			Type:  pemLabelPrefix + key.PublicKeyAsHex(),
+			Bytes: []byte(hex.EncodeToString(key.PrivateKey)),
+		}
+		err := pem.Encode(buff, blk)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return buff.Bytes(), nil
+}
+
+// LoadKeysFromPemFile loads all the validator keys from a .pem file, in the order they appear in the file
+func (manager *validatorKeysManager) LoadKeysFromPemFile(filename string) ([]*ValidatorKey, error) {
+	buff, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager.LoadKeysFromPemData(buff)
+}
+
+// LoadKeysFromPemData returns all the validator keys from the .pem data, in the order they appear in the data. The
+// public key from each label should match the private key
+func (manager *validatorKeysManager) LoadKeysFromPemData(buff []byte) ([]*ValidatorKey, error) {
+	keys := make([]*ValidatorKey, 0)
+	for {
+		blk, rest := pem.Decode(buff)
+		if blk == nil {
+			break
+		}
+		buff = rest
+
+		key, err := manager.validatorKeyFromPemBlock(blk)
+		if err != nil {
+			return nil, fmt.Errorf("%w: key %d, %s", ErrInvalidPemFile, len(keys), err.Error())
+		}
+
+		keys = append(keys, key)
+	}
+
+	if len(keys) == 0 {
+		return nil, ErrInvalidPemFile
+	}
+
+	return keys, nil
+}
+
+func (manager *validatorKeysManager) validatorKeyFromPemBlock(blk *pem.Block) (*ValidatorKey, error) {
+	privateKeyBytes, err := hex.DecodeString(string(blk.Bytes))
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := manager.ValidatorKeyFromPrivateKey(privateKeyBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	label := strings.TrimPrefix(blk.Type, pemLabelPrefix)
+	if label != key.PublicKeyAsHex() {
+		return nil, fmt.Errorf("%w: label %s, computed %s", ErrPublicKeyMismatch, label, key.PublicKeyAsHex())
+	}
+
+	return key, nil
+}
+
+// ComputeStakingSignature returns the signature required by the validator system smart contract when registering the
+// key: the BLS signature of the address that sends the stake transaction (the owner of the validator key). The reward
+// address, if different, is not part of the signed data
+func (manager *validatorKeysManager) ComputeStakingSignature(key *ValidatorKey, ownerAddress core.AddressHandler) ([]byte, error) {
+	if key == nil {
+		return nil, ErrNilValidatorKey
+	}
+	if check.IfNil(ownerAddress) {
+		return nil, ErrNilAddressHandler
+	}
+
+	privateKey, err := manager.keyGenerator.PrivateKeyFromByteArray(key.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return manager.signer.Sign(privateKey, ownerAddress.AddressBytes())
+}
+
+// VerifyStakingSignature checks the signature of the owner address produced by the validator key
+func (manager *validatorKeysManager) VerifyStakingSignature(publicKey []byte, ownerAddress core.AddressHandler, signature []byte) error {
+	if check.IfNil(ownerAddress) {
+		return ErrNilAddressHandler
+	}
+
+	pk, err := manager.keyGenerator.PublicKeyFromByteArray(publicKey)
+	if err != nil {
+		return err
+	}
+
+	return manager.signer.Verify(pk, ownerAddress.AddressBytes(), signature)
+}
+
+// CreateValidatorNodes returns the stake transaction arguments (the public key and the staking signature) for all the
+// provided keys, ready to be used by the staking builder's Stake and AddNodes functions
+func (manager *validatorKeysManager) CreateValidatorNodes(ownerAddress core.AddressHandler, keys ...*ValidatorKey) ([]builders.ValidatorNode, error) {
+	if len(keys) == 0 {
+		return nil, ErrNoValidatorKeys
+	}
+
+	nodes := make([]builders.ValidatorNode, 0, len(keys))
+	for _, key := range keys {
+		signature, err := manager.ComputeStakingSignature(key, ownerAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		nodes = append(nodes, builders.ValidatorNode{
+			BLSKey:    key.PublicKey,
+			Signature: signature,
+		})
+	}
+
+	return nodes, nil
+}
@@ -22,3 +22,51 @@ var ErrNilMinimumBalance = errors.New("nil minimum balance")
 
 // ErrNilTransactionInteractor signals that a nil transaction interactor was provided
 var ErrNilTransactionInteractor = errors.New("nil transaction interactor")
+
+// ErrNilGuardianCoSigner signals that a nil guardian co-signer was provided
+var ErrNilGuardianCoSigner = errors.New("nil guardian co-signer")
+
+// ErrNilGuardianData signals that a nil guardian data was received
+var ErrNilGuardianData = errors.New("nil guardian data")
+
+// ErrNoActiveGuardian signals that the account does not have an active guardian
+var ErrNoActiveGuardian = errors.New("no active guardian")
+
+// ErrNoPendingGuardian signals that the account does not have a pending guardian
+var ErrNoPendingGuardian = errors.New("no pending guardian")
+
+// ErrAccountAlreadyGuarded signals that the account is already guarded
+var ErrAccountAlreadyGuarded = errors.New("account already guarded")
+
+// ErrAccountNotGuarded signals that the account is not guarded
+var ErrAccountNotGuarded = errors.New("account not guarded")
+
+// ErrNilCryptoComponentsHolder signals that a nil crypto components holder was provided
+var ErrNilCryptoComponentsHolder = errors.New("nil crypto components holder")
+
+// ErrNilOfflineTxSigner signals that a nil offline transaction signer was provided
+var ErrNilOfflineTxSigner = errors.New("nil offline transaction signer")
+
+// ErrInvalidOfflineTransactionsFile signals that the offline transactions file does not follow the expected schema
+var ErrInvalidOfflineTransactionsFile = errors.New("invalid offline transactions file")
+
+// ErrChainIDMismatch signals that the chain ID is not the expected one
+var ErrChainIDMismatch = errors.New("chain ID mismatch")
+
+// ErrUnsignedHashMismatch signals that the unsigned transaction does not match its recorded or expected unsigned hash
+var ErrUnsignedHashMismatch = errors.New("unsigned transaction hash mismatch")
+
+// ErrTransactionHashMismatch signals that the signed transaction was altered after it was signed
+var ErrTransactionHashMismatch = errors.New("transaction hash mismatch")
+
+// ErrTransactionAlreadySigned signals that the transaction is already signed
+var ErrTransactionAlreadySigned = errors.New("transaction already signed")
+
+// ErrTransactionNotSigned signals that the transaction is not signed
+var ErrTransactionNotSigned = errors.New("transaction not signed")
+
+// ErrSenderMismatch signals that the signing key does not belong to the transaction's sender
+var ErrSenderMismatch = errors.New("sender mismatch")
+
+// ErrNilTransaction signals that a nil transaction was provided
+var ErrNilTransaction = errors.New("nil transaction")
@@ -0,0 +1,293 @@
+package workflows
+
+import (
+	"context"
+	"sync"
+
+	"github.com/multiversx/mx-chain-core-go/core"
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-sdk-go/builders"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+)
+
+// GuardianWorkflowArgs is the argument DTO for the NewGuardianWorkflow constructor function
+type GuardianWorkflowArgs struct {
+	Proxy    GuardianProxy
+	CoSigner GuardianCoSigner
+	// AddressConverter is optional, when not provided the default erd prefixed address converter is used
+	AddressConverter sdkCore.AddressConverter
+}
+
+// GuardianStatus holds the guardian state of an account, together with the current epoch
+type GuardianStatus struct {
+	Guarded         bool
+	ActiveGuardian  *api.Guardian
+	PendingGuardian *api.Guardian
+	CurrentEpoch    uint32
+}
+
+// guardianWorkflow is able to create the transactions needed to set a guardian and guard (or unguard) an account.
+// It also prepares the outgoing transactions of guarded accounts and collects the guardian co-signatures
+type guardianWorkflow struct {
+	proxy                   GuardianProxy
+	coSigner                GuardianCoSigner
+	addressConverter        sdkCore.AddressConverter
+	mutCachedNetworkConfigs sync.RWMutex
+	cachedNetConfigs        *data.NetworkConfig
+}
+
+// NewGuardianWorkflow creates a new instance of the guardianWorkflow struct
+func NewGuardianWorkflow(args GuardianWorkflowArgs) (*guardianWorkflow, error) {
+	if check.IfNil(args.Proxy) {
+		return nil, ErrNilProxy
+	}
+	if check.IfNil(args.CoSigner) {
+		return nil, ErrNilGuardianCoSigner
+	}
+
+	return &guardianWorkflow{
+		proxy:            args.Proxy,
+		coSigner:         args.CoSigner,
+		addressConverter: sdkCore.AddressConverterOrDefault(args.AddressConverter),
+	}, nil
+}
+
+// CacheNetworkConfigs will try to cache the network configs
+func (gw *guardianWorkflow) CacheNetworkConfigs(ctx context.Context) error {
+	cachedNetConfigs, err := gw.proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	gw.mutCachedNetworkConfigs.Lock()
+	gw.cachedNetConfigs = cachedNetConfigs
+	gw.mutCachedNetworkConfigs.Unlock()
+
+	return nil
+}
+
+// SetGuardian creates the unsigned SetGuardian transaction. If the account is already guarded, the transaction
+// will be prepared to be co-signed by the active guardian, so the new guardian is set without the activation delay
+func (gw *guardianWorkflow) SetGuardian(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+	guardian string,
+	serviceUID string,
+) (*transaction.FrontendTransaction, error) {
+	account, networkConfig, guardianData, err := gw.getAccountState(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	builder, err := builders.NewGuardianBuilderWithAddressConverter(networkConfig, gw.addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := builder.SetGuardian(account, guardian, serviceUID)
+	if err != nil {
+		return nil, err
+	}
+
+	return gw.applyGuardedOptionsIfNeeded(tx, guardianData, networkConfig)
+}
+
+// GetGuardianStatus returns the guardian state of the provided account. The pending guardian becomes the active one
+// once the current epoch reaches its activation epoch
+func (gw *guardianWorkflow) GetGuardianStatus(ctx context.Context, address sdkCore.AddressHandler) (*GuardianStatus, error) {
+	guardianData, err := gw.getGuardianData(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	networkStatus, err := gw.proxy.GetNetworkStatus(ctx, core.MetachainShardId)
+	if err != nil {
+		return nil, err
+	}
+
+	return &GuardianStatus{
+		Guarded:         guardianData.Guarded,
+		ActiveGuardian:  guardianData.ActiveGuardian,
+		PendingGuardian: guardianData.PendingGuardian,
+		CurrentEpoch:    uint32(networkStatus.EpochNumber),
+	}, nil
+}
+
+// GetPendingGuardianActivationEpoch returns the epoch when the pending guardian of the provided account becomes active
+func (gw *guardianWorkflow) GetPendingGuardianActivationEpoch(ctx context.Context, address sdkCore.AddressHandler) (uint32, error) {
+	guardianData, err := gw.getGuardianData(ctx, address)
+	if err != nil {
+		return 0, err
+	}
+	if guardianData.PendingGuardian == nil {
+		return 0, ErrNoPendingGuardian
+	}
+
+	return guardianData.PendingGuardian.ActivationEpoch, nil
+}
+
+// GuardAccount creates the unsigned GuardAccount transaction. The account should have an active guardian
+func (gw *guardianWorkflow) GuardAccount(ctx context.Context, address sdkCore.AddressHandler) (*transaction.FrontendTransaction, error) {
+	account, networkConfig, guardianData, err := gw.getAccountState(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	if guardianData.Guarded {
+		return nil, ErrAccountAlreadyGuarded
+	}
+	if guardianData.ActiveGuardian == nil {
+		return nil, ErrNoActiveGuardian
+	}
+
+	builder, err := builders.NewGuardianBuilderWithAddressConverter(networkConfig, gw.addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return builder.GuardAccount(account)
+}
+
+// UnGuardAccount creates the unsigned UnGuardAccount transaction, prepared to be co-signed by the active guardian
+func (gw *guardianWorkflow) UnGuardAccount(ctx context.Context, address sdkCore.AddressHandler) (*transaction.FrontendTransaction, error) {
+	account, networkConfig, guardianData, err := gw.getAccountState(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	if !guardianData.Guarded {
+		return nil, ErrAccountNotGuarded
+	}
+
+	builder, err := builders.NewGuardianBuilderWithAddressConverter(networkConfig, gw.addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	tx, err := builder.UnGuardAccount(account)
+	if err != nil {
+		return nil, err
+	}
+
+	return gw.applyGuardedOptionsIfNeeded(tx, guardianData, networkConfig)
+}
+
+// ApplyGuardedOptionsIfNeeded sets the guardian address, the guarded option and version on the provided unsigned
+// transaction if its sender is guarded. Should be called before applying the user signature
+func (gw *guardianWorkflow) ApplyGuardedOptionsIfNeeded(ctx context.Context, tx *transaction.FrontendTransaction) error {
+	if tx == nil {
+		return builders.ErrNilTransaction
+	}
+
+	sender, err := data.NewAddressFromBech32StringWithConverter(tx.Sender, gw.addressConverter)
+	if err != nil {
+		return err
+	}
+
+	guardianData, err := gw.getGuardianData(ctx, sender)
+	if err != nil {
+		return err
+	}
+
+	networkConfig, err := gw.getNetworkConfig(ctx)
+	if err != nil {
+		return err
+	}
+
+	_, err = gw.applyGuardedOptionsIfNeeded(tx, guardianData, networkConfig)
+
+	return err
+}
+
+// CoSignTransaction collects the guardian signature from the co-signer. Transactions that are not guarded are
+// left untouched
+func (gw *guardianWorkflow) CoSignTransaction(ctx context.Context, tx *transaction.FrontendTransaction) error {
+	if tx == nil {
+		return builders.ErrNilTransaction
+	}
+	if tx.Options&transaction.MaskGuardedTransaction == 0 {
+		return nil
+	}
+
+	return gw.coSigner.CoSignTransaction(ctx, tx)
+}
+
+func (gw *guardianWorkflow) applyGuardedOptionsIfNeeded(
+	tx *transaction.FrontendTransaction,
+	guardianData *api.GuardianData,
+	networkConfig *data.NetworkConfig,
+) (*transaction.FrontendTransaction, error) {
+	if !guardianData.Guarded {
+		return tx, nil
+	}
+	if guardianData.ActiveGuardian == nil {
+		return nil, ErrNoActiveGuardian
+	}
+
+	err := builders.ApplyGuardedTransactionOptionsWithAddressConverter(tx, guardianData.ActiveGuardian.Address, networkConfig, gw.addressConverter)
+	if err != nil {
+		return nil, err
+	}
+
+	return tx, nil
+}
+
+func (gw *guardianWorkflow) getAccountState(
+	ctx context.Context,
+	address sdkCore.AddressHandler,
+) (*data.Account, *data.NetworkConfig, *api.GuardianData, error) {
+	networkConfig, err := gw.getNetworkConfig(ctx)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	account, err := gw.proxy.GetAccount(ctx, address)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	guardianData, err := gw.getGuardianData(ctx, address)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	return account, networkConfig, guardianData, nil
+}
+
+func (gw *guardianWorkflow) getGuardianData(ctx context.Context, address sdkCore.AddressHandler) (*api.GuardianData, error) {
+	guardianData, err := gw.proxy.GetGuardianData(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	if guardianData == nil {
+		return nil, ErrNilGuardianData
+	}
+
+	return guardianData, nil
+}
+
+func (gw *guardianWorkflow) getNetworkConfig(ctx context.Context) (*data.NetworkConfig, error) {
+	gw.mutCachedNetworkConfigs.RLock()
+	networkConfig := gw.cachedNetConfigs
+	gw.mutCachedNetworkConfigs.RUnlock()
+
+	if networkConfig != nil {
+		return networkConfig, nil
+	}
+
+	err := gw.CacheNetworkConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	gw.mutCachedNetworkConfigs.RLock()
+	defer gw.mutCachedNetworkConfigs.RUnlock()
+
+	return gw.cachedNetConfigs, nil
+}
+
+// IsInterfaceNil returns true if there is no value under the interface
+func (gw *guardianWorkflow) IsInterfaceNil() bool {
+	return gw == nil
+}
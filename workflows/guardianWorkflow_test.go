@@ -0,0 +1,195 @@
+package workflows
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/api"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testGuardedAccount = "erd1h692scsz3um6e5qwzts4yjrewxqxwcwxzavl5n9q8sprussx8fqsu70jf5"
+	testGuardian       = "erd1lta2vgd0tkeqqadkvgef73y0efs6n3xe5ss589ufhvmt6tcur8kq34qkwr"
+)
+
+func createGuardianProxyStub(guardianData *api.GuardianData) *testsCommon.ProxyStub {
+	return &testsCommon.ProxyStub{
+		GetNetworkConfigCalled: func() (*data.NetworkConfig, error) {
+			return &data.NetworkConfig{
+				ChainID:                "T",
+				MinTransactionVersion:  1,
+				GasPerDataByte:         1500,
+				MinGasLimit:            50000,
+				MinGasPrice:            1000000000,
+				ExtraGasLimitGuardedTx: 50000,
+			}, nil
+		},
+		GetAccountCalled: func(address sdkCore.AddressHandler) (*data.Account, error) {
+			bech32, _ := address.AddressAsBech32String()
+			return &data.Account{Address: bech32, Nonce: 5}, nil
+		},
+		GetGuardianDataCalled: func(ctx context.Context, address sdkCore.AddressHandler) (*api.GuardianData, error) {
+			return guardianData, nil
+		},
+		GetNetworkStatusCalled: func(ctx context.Context, shardID uint32) (*data.NetworkStatus, error) {
+			return &data.NetworkStatus{EpochNumber: 12}, nil
+		},
+	}
+}
+
+func createGuardianWorkflow(t *testing.T, guardianData *api.GuardianData) *guardianWorkflow {
+	gw, err := NewGuardianWorkflow(GuardianWorkflowArgs{
+		Proxy:    createGuardianProxyStub(guardianData),
+		CoSigner: &testsCommon.GuardianCoSignerStub{},
+	})
+	require.Nil(t, err)
+
+	return gw
+}
+
+func TestNewGuardianWorkflow(t *testing.T) {
+	t.Parallel()
+
+	gw, err := NewGuardianWorkflow(GuardianWorkflowArgs{CoSigner: &testsCommon.GuardianCoSignerStub{}})
+	assert.True(t, check.IfNil(gw))
+	assert.Equal(t, ErrNilProxy, err)
+
+	gw, err = NewGuardianWorkflow(GuardianWorkflowArgs{Proxy: &testsCommon.ProxyStub{}})
+	assert.True(t, check.IfNil(gw))
+	assert.Equal(t, ErrNilGuardianCoSigner, err)
+
+	gw, err = NewGuardianWorkflow(GuardianWorkflowArgs{
+		Proxy:    &testsCommon.ProxyStub{},
+		CoSigner: &testsCommon.GuardianCoSignerStub{},
+	})
+	assert.False(t, check.IfNil(gw))
+	assert.Nil(t, err)
+}
+
+func TestGuardianWorkflow_SetGuardian(t *testing.T) {
+	t.Parallel()
+
+	address, _ := data.NewAddressFromBech32String(testGuardedAccount)
+
+	t.Run("not guarded account should not set the guarded options", func(t *testing.T) {
+		t.Parallel()
+
+		gw := createGuardianWorkflow(t, &api.GuardianData{})
+		tx, err := gw.SetGuardian(context.Background(), address, testGuardian, "uid")
+		require.Nil(t, err)
+		assert.Equal(t, uint64(5), tx.Nonce)
+		assert.Empty(t, tx.GuardianAddr)
+		assert.Equal(t, uint32(0), tx.Options)
+	})
+	t.Run("guarded account should set the guarded options", func(t *testing.T) {
+		t.Parallel()
+
+		gw := createGuardianWorkflow(t, &api.GuardianData{
+			Guarded:        true,
+			ActiveGuardian: &api.Guardian{Address: testGuardian},
+		})
+		tx, err := gw.SetGuardian(context.Background(), address, testGuardedAccount, "uid")
+		require.Nil(t, err)
+		assert.Equal(t, testGuardian, tx.GuardianAddr)
+		assert.Equal(t, transaction.MaskGuardedTransaction, tx.Options)
+		assert.Equal(t, uint32(2), tx.Version)
+	})
+}
+
+func TestGuardianWorkflow_GuardianStatus(t *testing.T) {
+	t.Parallel()
+
+	address, _ := data.NewAddressFromBech32String(testGuardedAccount)
+
+	gw := createGuardianWorkflow(t, &api.GuardianData{})
+	epoch, err := gw.GetPendingGuardianActivationEpoch(context.Background(), address)
+	assert.Equal(t, uint32(0), epoch)
+	assert.Equal(t, ErrNoPendingGuardian, err)
+
+	pendingGuardian := &api.Guardian{Address: testGuardian, ActivationEpoch: 32}
+	gw = createGuardianWorkflow(t, &api.GuardianData{PendingGuardian: pendingGuardian})
+	epoch, err = gw.GetPendingGuardianActivationEpoch(context.Background(), address)
+	assert.Nil(t, err)
+	assert.Equal(t, uint32(32), epoch)
+
+	status, err := gw.GetGuardianStatus(context.Background(), address)
+	assert.Nil(t, err)
+	assert.Equal(t, &GuardianStatus{PendingGuardian: pendingGuardian, CurrentEpoch: 12}, status)
+}
+
+func TestGuardianWorkflow_GuardAndUnGuardAccount(t *testing.T) {
+	t.Parallel()
+
+	address, _ := data.NewAddressFromBech32String(testGuardedAccount)
+	activeGuardian := &api.Guardian{Address: testGuardian}
+
+	gw := createGuardianWorkflow(t, &api.GuardianData{})
+	tx, err := gw.GuardAccount(context.Background(), address)
+	assert.Nil(t, tx)
+	assert.Equal(t, ErrNoActiveGuardian, err)
+
+	tx, err = gw.UnGuardAccount(context.Background(), address)
+	assert.Nil(t, tx)
+	assert.Equal(t, ErrAccountNotGuarded, err)
+
+	gw = createGuardianWorkflow(t, &api.GuardianData{ActiveGuardian: activeGuardian})
+	tx, err = gw.GuardAccount(context.Background(), address)
+	require.Nil(t, err)
+	assert.Equal(t, "GuardAccount", string(tx.Data))
+
+	gw = createGuardianWorkflow(t, &api.GuardianData{ActiveGuardian: activeGuardian, Guarded: true})
+	tx, err = gw.GuardAccount(context.Background(), address)
+	assert.Nil(t, tx)
+	assert.Equal(t, ErrAccountAlreadyGuarded, err)
+
+	tx, err = gw.UnGuardAccount(context.Background(), address)
+	require.Nil(t, err)
+	assert.Equal(t, "UnGuardAccount", string(tx.Data))
+	assert.Equal(t, testGuardian, tx.GuardianAddr)
+	assert.Equal(t, transaction.MaskGuardedTransaction, tx.Options)
+}
+
+func TestGuardianWorkflow_ApplyGuardedOptionsAndCoSign(t *testing.T) {
+	t.Parallel()
+
+	expectedErr := errors.New("expected error")
+	numCoSignCalls := 0
+	gw, _ := NewGuardianWorkflow(GuardianWorkflowArgs{
+		Proxy: createGuardianProxyStub(&api.GuardianData{
+			Guarded:        true,
+			ActiveGuardian: &api.Guardian{Address: testGuardian},
+		}),
+		CoSigner: &testsCommon.GuardianCoSignerStub{
+			CoSignTransactionCalled: func(ctx context.Context, tx *transaction.FrontendTransaction) error {
+				numCoSignCalls++
+				return expectedErr
+			},
+		},
+	})
+
+	tx := &transaction.FrontendTransaction{
+		Sender:   testGuardedAccount,
+		GasLimit: 50000,
+		Version:  1,
+	}
+	err := gw.CoSignTransaction(context.Background(), tx)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, numCoSignCalls)
+
+	err = gw.ApplyGuardedOptionsIfNeeded(context.Background(), tx)
+	require.Nil(t, err)
+	assert.Equal(t, testGuardian, tx.GuardianAddr)
+	assert.Equal(t, uint64(100000), tx.GasLimit)
+
+	err = gw.CoSignTransaction(context.Background(), tx)
+	assert.Equal(t, expectedErr, err)
+	assert.Equal(t, 1, numCoSignCalls)
+}
@@ -3,6 +3,7 @@ package workflows
 import (
 	"context"
 
+	"github.com/multiversx/mx-chain-core-go/data/api"
 	"github.com/multiversx/mx-chain-core-go/data/transaction"
 	sdkCore "github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/data"
@@ -39,3 +40,33 @@ type TransactionInteractor interface {
 	ApplyUserSignature(cryptoHolder sdkCore.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error
 	IsInterfaceNil() bool
 }
+
+// GuardianProxy defines the proxy methods used by the guardian workflow
+type GuardianProxy interface {
+	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
+	GetNetworkStatus(ctx context.Context, shardID uint32) (*data.NetworkStatus, error)
+	GetAccount(ctx context.Context, address sdkCore.AddressHandler) (*data.Account, error)
+	GetGuardianData(ctx context.Context, address sdkCore.AddressHandler) (*api.GuardianData, error)
+	IsInterfaceNil() bool
+}
+
+// GuardianCoSigner defines the component able to apply the guardian signature on guarded transactions
+// (for example, a trusted co-signer service)
+type GuardianCoSigner interface {
+	CoSignTransaction(ctx context.Context, tx *transaction.FrontendTransaction) error
+	IsInterfaceNil() bool
+}
+
+// OfflineTxSigner defines the component able to sign transactions and compute their hashes (for example, the txBuilder)
+type OfflineTxSigner interface {
+	ApplyUserSignature(cryptoHolder sdkCore.CryptoComponentsHolder, tx *transaction.FrontendTransaction) error
+	ComputeTxHash(tx *transaction.FrontendTransaction) ([]byte, error)
+	IsInterfaceNil() bool
+}
+
+// OfflineTransactionsProxy defines the proxy methods used when broadcasting offline signed transactions
+type OfflineTransactionsProxy interface {
+	GetNetworkConfig(ctx context.Context) (*data.NetworkConfig, error)
+	SendTransactions(ctx context.Context, txs []*transaction.FrontendTransaction) ([]string, error)
+	IsInterfaceNil() bool
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/multiversx/mx-chain-crypto-go/signing"
 	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
 	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/core"
 	"github.com/multiversx/mx-sdk-go/data"
 )
 
@@ -27,6 +28,8 @@ type MoveBalanceHandlerArgs struct {
 	ReceiverAddress            string
 	TrackableAddressesProvider TrackableAddressesProvider
 	MinimumBalance             *big.Int
+	// AddressConverter is optional, when not provided the default erd prefixed address converter is used
+	AddressConverter core.AddressConverter
 }
 
 // moveBalanceHandler is an implementation that can create move balance transactions that will empty the balance
@@ -39,6 +42,7 @@ type moveBalanceHandler struct {
 	trackableAddressesProvider TrackableAddressesProvider
 	receiverAddress            string
 	minimumBalance             *big.Int
+	addressConverter           core.AddressConverter
 }
 
 // NewMoveBalanceHandler creates a new instance of the moveBalanceHandler struct
@@ -62,6 +66,7 @@ func NewMoveBalanceHandler(args MoveBalanceHandlerArgs) (*moveBalanceHandler, er
 		trackableAddressesProvider: args.TrackableAddressesProvider,
 		receiverAddress:            args.ReceiverAddress,
 		minimumBalance:             args.MinimumBalance,
+		addressConverter:           core.AddressConverterOrDefault(args.AddressConverter),
 	}
 
 	return mbh, nil
@@ -98,7 +103,7 @@ func (mbh *moveBalanceHandler) generateTransactionAndHandleErrors(ctx context.Co
 }
 
 func (mbh *moveBalanceHandler) generateTransaction(ctx context.Context, address string) error {
-	addressHandler, err := data.NewAddressFromBech32String(address)
+	addressHandler, err := data.NewAddressFromBech32StringWithConverter(address, mbh.addressConverter)
 	if err != nil {
 		return err
 	}
@@ -140,7 +145,7 @@ func (mbh *moveBalanceHandler) generateTransaction(ctx context.Context, address
 
 	skBytes := mbh.trackableAddressesProvider.PrivateKeyOfBech32Address(address)
 
-	cryptoHolder, err := cryptoProvider.NewCryptoComponentsHolder(keyGen, skBytes)
+	cryptoHolder, err := cryptoProvider.NewCryptoComponentsHolderWithAddressConverter(keyGen, skBytes, mbh.addressConverter)
 	if err != nil {
 		return err
 	}
@@ -150,7 +155,7 @@ func (mbh *moveBalanceHandler) generateTransaction(ctx context.Context, address
 		return err
 	}
 
-	log.Debug("adding transaction", "from", address, "to", mbh.receiverAddress, "value", value.String())
+	log.Debug("adding transaction", "from", address, "to", mbh.receiverAddress, "value", data.NewEGLDAmount(value).String())
 	mbh.txInteractor.AddTransaction(&tx)
 
 	return nil
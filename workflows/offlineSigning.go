@@ -0,0 +1,317 @@
+package workflows
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/multiversx/mx-chain-core-go/core/check"
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-core-go/hashing/blake2b"
+	"github.com/multiversx/mx-sdk-go/builders"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+)
+
+// OfflineTransactionsFileVersion is the current schema version of the offline transactions files
+const OfflineTransactionsFileVersion = 1
+
+const offlineTransactionsFilePermissions = 0600
+
+var unsignedTxHasher = blake2b.NewBlake2b()
+
+// OfflineTransactionsFile is the canonical JSON document carried between the online machine, that prepares the
+// transactions, and the air-gapped machine, that signs them
+type OfflineTransactionsFile struct {
+	Version      uint32                `json:"version"`
+	ChainID      string                `json:"chainID"`
+	Transactions []*OfflineTransaction `json:"transactions"`
+}
+
+// OfflineTransaction holds a transaction together with the hash of its unsigned form, computed when the transaction
+// was exported, and the transaction hash, computed when the transaction was signed. Both hashes travel in the same file
+// as the transaction, so they only detect corruption, not deliberate alteration
+type OfflineTransaction struct {
+	Transaction  *transaction.FrontendTransaction `json:"transaction"`
+	UnsignedHash string                           `json:"unsignedHash"`
+	Hash         string                           `json:"hash,omitempty"`
+}
+
+// ExportUnsignedTransactions creates the offline transactions file from the prepared (nonce, gas and network data
+// filled in) unsigned transactions. All the transactions should have the provided chain ID
+func ExportUnsignedTransactions(chainID string, txs []*transaction.FrontendTransaction) (*OfflineTransactionsFile, error) {
+	if len(chainID) == 0 {
+		return nil, fmt.Errorf("%w: empty chain ID", ErrInvalidOfflineTransactionsFile)
+	}
+	if len(txs) == 0 {
+		return nil, fmt.Errorf("%w: no transactions", ErrInvalidOfflineTransactionsFile)
+	}
+
+	file := &OfflineTransactionsFile{
+		Version:      OfflineTransactionsFileVersion,
+		ChainID:      chainID,
+		Transactions: make([]*OfflineTransaction, 0, len(txs)),
+	}
+	for idx, tx := range txs {
+		if tx == nil {
+			return nil, fmt.Errorf("%w at index %d", ErrNilTransaction, idx)
+		}
+		if tx.ChainID != chainID {
+			return nil, fmt.Errorf("%w at index %d: expected %s, got %s", ErrChainIDMismatch, idx, chainID, tx.ChainID)
+		}
+		if len(tx.Signature) > 0 {
+			return nil, fmt.Errorf("%w at index %d", ErrTransactionAlreadySigned, idx)
+		}
+
+		unsignedHash, err := computeUnsignedTxHash(tx)
+		if err != nil {
+			return nil, fmt.Errorf("%w at index %d", err, idx)
+		}
+
+		file.Transactions = append(file.Transactions, &OfflineTransaction{
+			Transaction:  tx,
+			UnsignedHash: hex.EncodeToString(unsignedHash),
+		})
+	}
+
+	return file, nil
+}
+
+// UnsignedHashes returns the unsigned hashes of the file's transactions, in order. They should be carried to the
+// air-gapped machine on a separate (out-of-band) channel and provided to SignOfflineTransactions
+func (file *OfflineTransactionsFile) UnsignedHashes() []string {
+	hashes := make([]string, 0, len(file.Transactions))
+	for _, offlineTx := range file.Transactions {
+		hashes = append(hashes, offlineTx.UnsignedHash)
+	}
+
+	return hashes
+}
+
+// SaveOfflineTransactionsFile writes the offline transactions file as indented JSON
+func SaveOfflineTransactionsFile(filename string, file *OfflineTransactionsFile) error {
+	err := file.checkSchema()
+	if err != nil {
+		return err
+	}
+
+	buff, err := json.MarshalIndent(file, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, buff, offlineTransactionsFilePermissions)
+}
+
+// LoadOfflineTransactionsFile reads the offline transactions file and checks its schema. Unknown fields are rejected
+func LoadOfflineTransactionsFile(filename string) (*OfflineTransactionsFile, error) {
+	buff, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	decoder := json.NewDecoder(bytes.NewReader(buff))
+	decoder.DisallowUnknownFields()
+
+	file := &OfflineTransactionsFile{}
+	err = decoder.Decode(file)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidOfflineTransactionsFile, err.Error())
+	}
+
+	err = file.checkSchema()
+	if err != nil {
+		return nil, err
+	}
+
+	return file, nil
+}
+
+// SignOfflineTransactions signs, on the air-gapped machine, all the transactions from the file with the provided key.
+// The file's chain ID should be the expected one and the unsigned hash of each transaction should match the expected
+// unsigned hashes, obtained from the online machine on a channel separate from the file. The transaction hashes are
+// recorded so the broadcast step can check them
+func SignOfflineTransactions(
+	file *OfflineTransactionsFile,
+	expectedChainID string,
+	expectedUnsignedHashes []string,
+	cryptoHolder sdkCore.CryptoComponentsHolder,
+	txSigner OfflineTxSigner,
+) error {
+	if check.IfNil(cryptoHolder) {
+		return ErrNilCryptoComponentsHolder
+	}
+	if check.IfNil(txSigner) {
+		return ErrNilOfflineTxSigner
+	}
+	err := file.checkChainID(expectedChainID)
+	if err != nil {
+		return err
+	}
+	if len(expectedUnsignedHashes) != len(file.Transactions) {
+		return fmt.Errorf("%w: expected %d unsigned hashes, file has %d transactions",
+			ErrUnsignedHashMismatch, len(expectedUnsignedHashes), len(file.Transactions))
+	}
+
+	for idx, offlineTx := range file.Transactions {
+		tx := offlineTx.Transaction
+		if len(tx.Signature) > 0 || len(offlineTx.Hash) > 0 {
+			return fmt.Errorf("%w at index %d", ErrTransactionAlreadySigned, idx)
+		}
+		if tx.Sender != cryptoHolder.GetBech32() {
+			return fmt.Errorf("%w at index %d: transaction sender %s, signing key of %s",
+				ErrSenderMismatch, idx, tx.Sender, cryptoHolder.GetBech32())
+		}
+		err = offlineTx.checkUnsignedHash(idx, expectedUnsignedHashes[idx])
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, offlineTx := range file.Transactions {
+		err = txSigner.ApplyUserSignature(cryptoHolder, offlineTx.Transaction)
+		if err != nil {
+			return err
+		}
+
+		hash, errHash := txSigner.ComputeTxHash(offlineTx.Transaction)
+		if errHash != nil {
+			return errHash
+		}
+		offlineTx.Hash = hex.EncodeToString(hash)
+	}
+
+	return nil
+}
+
+// BroadcastOfflineTransactions sends the signed transactions from the file, after checking that the file was prepared
+// for the proxy's network and that the recorded hashes still match the transactions (a corruption check: an altered
+// signed transaction is rejected by the network, as its signature no longer verifies). Returns the transaction hashes
+func BroadcastOfflineTransactions(
+	ctx context.Context,
+	file *OfflineTransactionsFile,
+	proxy OfflineTransactionsProxy,
+	txSigner OfflineTxSigner,
+) ([]string, error) {
+	if check.IfNil(proxy) {
+		return nil, ErrNilProxy
+	}
+	if check.IfNil(txSigner) {
+		return nil, ErrNilOfflineTxSigner
+	}
+
+	networkConfig, err := proxy.GetNetworkConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	err = file.checkChainID(networkConfig.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	txs := make([]*transaction.FrontendTransaction, 0, len(file.Transactions))
+	for idx, offlineTx := range file.Transactions {
+		if len(offlineTx.Transaction.Signature) == 0 || len(offlineTx.Hash) == 0 {
+			return nil, fmt.Errorf("%w at index %d", ErrTransactionNotSigned, idx)
+		}
+		err = offlineTx.checkUnsignedHash(idx, offlineTx.UnsignedHash)
+		if err != nil {
+			return nil, err
+		}
+
+		hash, errHash := txSigner.ComputeTxHash(offlineTx.Transaction)
+		if errHash != nil {
+			return nil, errHash
+		}
+		if hex.EncodeToString(hash) != offlineTx.Hash {
+			return nil, fmt.Errorf("%w at index %d: recorded %s, computed %s",
+				ErrTransactionHashMismatch, idx, offlineTx.Hash, hex.EncodeToString(hash))
+		}
+
+		txs = append(txs, offlineTx.Transaction)
+	}
+
+	hashes, err := proxy.SendTransactions(ctx, txs)
+	if err != nil {
+		return hashes, err
+	}
+	for idx, hash := range hashes {
+		if idx < len(file.Transactions) && hash != file.Transactions[idx].Hash {
+			return hashes, fmt.Errorf("%w at index %d: recorded %s, returned by the network %s",
+				ErrTransactionHashMismatch, idx, file.Transactions[idx].Hash, hash)
+		}
+	}
+
+	return hashes, nil
+}
+
+func (file *OfflineTransactionsFile) checkSchema() error {
+	if file == nil {
+		return fmt.Errorf("%w: nil file", ErrInvalidOfflineTransactionsFile)
+	}
+	if file.Version != OfflineTransactionsFileVersion {
+		return fmt.Errorf("%w: unsupported version %d, expected %d",
+			ErrInvalidOfflineTransactionsFile, file.Version, OfflineTransactionsFileVersion)
+	}
+	if len(file.ChainID) == 0 {
+		return fmt.Errorf("%w: empty chain ID", ErrInvalidOfflineTransactionsFile)
+	}
+	if len(file.Transactions) == 0 {
+		return fmt.Errorf("%w: no transactions", ErrInvalidOfflineTransactionsFile)
+	}
+
+	for idx, offlineTx := range file.Transactions {
+		if offlineTx == nil || offlineTx.Transaction == nil {
+			return fmt.Errorf("%w: missing transaction at index %d", ErrInvalidOfflineTransactionsFile, idx)
+		}
+		if offlineTx.Transaction.ChainID != file.ChainID {
+			return fmt.Errorf("%w at index %d: expected %s, got %s",
+				ErrChainIDMismatch, idx, file.ChainID, offlineTx.Transaction.ChainID)
+		}
+		_, err := hex.DecodeString(offlineTx.UnsignedHash)
+		if err != nil || len(offlineTx.UnsignedHash) == 0 {
+			return fmt.Errorf("%w: invalid unsigned hash at index %d", ErrInvalidOfflineTransactionsFile, idx)
+		}
+	}
+
+	return nil
+}
+
+func (file *OfflineTransactionsFile) checkChainID(expectedChainID string) error {
+	err := file.checkSchema()
+	if err != nil {
+		return err
+	}
+	if file.ChainID != expectedChainID {
+		return fmt.Errorf("%w: expected %s, file prepared for %s", ErrChainIDMismatch, expectedChainID, file.ChainID)
+	}
+
+	return nil
+}
+
+func (offlineTx *OfflineTransaction) checkUnsignedHash(idx int, expectedUnsignedHash string) error {
+	unsignedHash, err := computeUnsignedTxHash(offlineTx.Transaction)
+	if err != nil {
+		return fmt.Errorf("%w at index %d", err, idx)
+	}
+	computedUnsignedHash := hex.EncodeToString(unsignedHash)
+	if computedUnsignedHash != offlineTx.UnsignedHash || computedUnsignedHash != expectedUnsignedHash {
+		return fmt.Errorf("%w at index %d", ErrUnsignedHashMismatch, idx)
+	}
+
+	return nil
+}
+
+// computeUnsignedTxHash hashes the canonical JSON form of the transaction without its signatures, the same
+// form that is signed by the txBuilder. The hash is not keyed, anyone able to alter the transaction can recompute it
+func computeUnsignedTxHash(tx *transaction.FrontendTransaction) ([]byte, error) {
+	unsignedTx := builders.TransactionToUnsignedTx(tx)
+	buff, err := json.Marshal(unsignedTx)
+	if err != nil {
+		return nil, err
+	}
+
+	return unsignedTxHasher.Compute(string(buff)), nil
+}
@@ -0,0 +1,364 @@
+package workflows
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/multiversx/mx-chain-core-go/data/transaction"
+	"github.com/multiversx/mx-chain-crypto-go/signing"
+	"github.com/multiversx/mx-chain-crypto-go/signing/ed25519"
+	"github.com/multiversx/mx-sdk-go/blockchain/cryptoProvider"
+	"github.com/multiversx/mx-sdk-go/builders"
+	sdkCore "github.com/multiversx/mx-sdk-go/core"
+	"github.com/multiversx/mx-sdk-go/data"
+	"github.com/multiversx/mx-sdk-go/testsCommon"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	testOfflineChainID    = "T"
+	testOfflineSigningKey = "6ae10fed53a84029e53e35afdbe083688eea0917a09a9431951dd42fd4da14c40d248169f4dd7c90537f05be1c49772ddbf8f7948b507ed17fb23284cf218b7d"
+	testOfflineReceiver   = "erd1l20m7kzfht5rhdnd4zvqr82egk7m4nvv3zk06yw82zqmrt9kf0zsf9esqq"
+)
+
+func createOfflineCryptoHolder(t *testing.T) sdkCore.CryptoComponentsHolder {
+	sk, err := hex.DecodeString(testOfflineSigningKey)
+	require.Nil(t, err)
+
+	keyGen := signing.NewKeyGenerator(ed25519.NewEd25519())
+	holder, err := cryptoProvider.NewCryptoComponentsHolder(keyGen, sk)
+	require.Nil(t, err)
+
+	return holder
+}
+
+func createOfflineTxSigner(t *testing.T) OfflineTxSigner {
+	txSigner, err := builders.NewTxBuilder(cryptoProvider.NewSigner())
+	require.Nil(t, err)
+
+	return txSigner
+}
+
+func createOfflineTransactions(sender string, numTxs int) []*transaction.FrontendTransaction {
+	txs := make([]*transaction.FrontendTransaction, 0, numTxs)
+	for i := 0; i < numTxs; i++ {
+		txs = append(txs, &transaction.FrontendTransaction{
+			Nonce:    uint64(10 + i),
+			Value:    "1000000000000000000",
+			Receiver: testOfflineReceiver,
+			Sender:   sender,
+			GasPrice: 1000000000,
+			GasLimit: 50000,
+			ChainID:  testOfflineChainID,
+			Version:  1,
+		})
+	}
+
+	return txs
+}
+
+func createSignedOfflineFile(t *testing.T) *OfflineTransactionsFile {
+	holder := createOfflineCryptoHolder(t)
+	file, err := ExportUnsignedTransactions(testOfflineChainID, createOfflineTransactions(holder.GetBech32(), 2))
+	require.Nil(t, err)
+
+	err = SignOfflineTransactions(file, testOfflineChainID, file.UnsignedHashes(), holder, createOfflineTxSigner(t))
+	require.Nil(t, err)
+
+	return file
+}
+
+func TestExportUnsignedTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("empty chain ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		file, err := ExportUnsignedTransactions("", createOfflineTransactions(testGuardedAccount, 1))
+		assert.Nil(t, file)
+		assert.True(t, errors.Is(err, ErrInvalidOfflineTransactionsFile))
+	})
+	t.Run("no transactions should error", func(t *testing.T) {
+		t.Parallel()
+
+		file, err := ExportUnsignedTransactions(testOfflineChainID, nil)
+		assert.Nil(t, file)
+		assert.True(t, errors.Is(err, ErrInvalidOfflineTransactionsFile))
+	})
+	t.Run("nil transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		txs := createOfflineTransactions(testGuardedAccount, 1)
+		txs = append(txs, nil)
+		file, err := ExportUnsignedTransactions(testOfflineChainID, txs)
+		assert.Nil(t, file)
+		assert.True(t, errors.Is(err, ErrNilTransaction))
+	})
+	t.Run("different chain ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		txs := createOfflineTransactions(testGuardedAccount, 2)
+		txs[1].ChainID = "1"
+		file, err := ExportUnsignedTransactions(testOfflineChainID, txs)
+		assert.Nil(t, file)
+		assert.True(t, errors.Is(err, ErrChainIDMismatch))
+	})
+	t.Run("signed transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		txs := createOfflineTransactions(testGuardedAccount, 1)
+		txs[0].Signature = "aa"
+		file, err := ExportUnsignedTransactions(testOfflineChainID, txs)
+		assert.Nil(t, file)
+		assert.True(t, errors.Is(err, ErrTransactionAlreadySigned))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		file, err := ExportUnsignedTransactions(testOfflineChainID, createOfflineTransactions(testGuardedAccount, 2))
+		require.Nil(t, err)
+		assert.Equal(t, uint32(OfflineTransactionsFileVersion), file.Version)
+		assert.Equal(t, testOfflineChainID, file.ChainID)
+		require.Equal(t, 2, len(file.Transactions))
+		assert.NotEqual(t, file.Transactions[0].UnsignedHash, file.Transactions[1].UnsignedHash)
+		assert.Empty(t, file.Transactions[0].Hash)
+	})
+}
+
+func TestSaveAndLoadOfflineTransactionsFile(t *testing.T) {
+	t.Parallel()
+
+	t.Run("invalid file should not be saved", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(t.TempDir(), "txs.json")
+		err := SaveOfflineTransactionsFile(filename, &OfflineTransactionsFile{Version: 2})
+		assert.True(t, errors.Is(err, ErrInvalidOfflineTransactionsFile))
+	})
+	t.Run("unknown fields should error", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(t.TempDir(), "txs.json")
+		err := os.WriteFile(filename, []byte(`{"version":1,"chainID":"T","transactions":[],"extra":1}`), 0600)
+		require.Nil(t, err)
+
+		file, err := LoadOfflineTransactionsFile(filename)
+		assert.Nil(t, file)
+		assert.True(t, errors.Is(err, ErrInvalidOfflineTransactionsFile))
+	})
+	t.Run("unsupported version should error", func(t *testing.T) {
+		t.Parallel()
+
+		filename := filepath.Join(t.TempDir(), "txs.json")
+		err := os.WriteFile(filename, []byte(`{"version":2,"chainID":"T","transactions":[]}`), 0600)
+		require.Nil(t, err)
+
+		file, err := LoadOfflineTransactionsFile(filename)
+		assert.Nil(t, file)
+		assert.True(t, errors.Is(err, ErrInvalidOfflineTransactionsFile))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		file := createSignedOfflineFile(t)
+		filename := filepath.Join(t.TempDir(), "txs.json")
+		err := SaveOfflineTransactionsFile(filename, file)
+		require.Nil(t, err)
+
+		loaded, err := LoadOfflineTransactionsFile(filename)
+		require.Nil(t, err)
+		assert.Equal(t, file, loaded)
+	})
+}
+
+func TestSignOfflineTransactions(t *testing.T) {
+	t.Parallel()
+
+	t.Run("nil crypto holder should error", func(t *testing.T) {
+		t.Parallel()
+
+		file, _ := ExportUnsignedTransactions(testOfflineChainID, createOfflineTransactions(testGuardedAccount, 1))
+		err := SignOfflineTransactions(file, testOfflineChainID, file.UnsignedHashes(), nil, createOfflineTxSigner(t))
+		assert.Equal(t, ErrNilCryptoComponentsHolder, err)
+	})
+	t.Run("nil tx signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		file, _ := ExportUnsignedTransactions(testOfflineChainID, createOfflineTransactions(testGuardedAccount, 1))
+		err := SignOfflineTransactions(file, testOfflineChainID, file.UnsignedHashes(), createOfflineCryptoHolder(t), nil)
+		assert.Equal(t, ErrNilOfflineTxSigner, err)
+	})
+	t.Run("unexpected chain ID should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder := createOfflineCryptoHolder(t)
+		file, _ := ExportUnsignedTransactions(testOfflineChainID, createOfflineTransactions(holder.GetBech32(), 1))
+		err := SignOfflineTransactions(file, "1", file.UnsignedHashes(), holder, createOfflineTxSigner(t))
+		assert.True(t, errors.Is(err, ErrChainIDMismatch))
+	})
+	t.Run("different sender should error", func(t *testing.T) {
+		t.Parallel()
+
+		file, _ := ExportUnsignedTransactions(testOfflineChainID, createOfflineTransactions(testGuardedAccount, 1))
+		err := SignOfflineTransactions(file, testOfflineChainID, file.UnsignedHashes(), createOfflineCryptoHolder(t), createOfflineTxSigner(t))
+		assert.True(t, errors.Is(err, ErrSenderMismatch))
+		assert.Empty(t, file.Transactions[0].Transaction.Signature)
+	})
+	t.Run("altered transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder := createOfflineCryptoHolder(t)
+		file, _ := ExportUnsignedTransactions(testOfflineChainID, createOfflineTransactions(holder.GetBech32(), 2))
+		file.Transactions[1].Transaction.Value = "2000000000000000000"
+		err := SignOfflineTransactions(file, testOfflineChainID, file.UnsignedHashes(), holder, createOfflineTxSigner(t))
+		assert.True(t, errors.Is(err, ErrUnsignedHashMismatch))
+		assert.Empty(t, file.Transactions[0].Transaction.Signature)
+	})
+	t.Run("altered transaction with recomputed unsigned hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder := createOfflineCryptoHolder(t)
+		file, _ := ExportUnsignedTransactions(testOfflineChainID, createOfflineTransactions(holder.GetBech32(), 2))
+		expectedUnsignedHashes := file.UnsignedHashes()
+		file.Transactions[1].Transaction.Receiver = testGuardedAccount
+		unsignedHash, err := computeUnsignedTxHash(file.Transactions[1].Transaction)
+		require.Nil(t, err)
+		file.Transactions[1].UnsignedHash = hex.EncodeToString(unsignedHash)
+
+		err = SignOfflineTransactions(file, testOfflineChainID, expectedUnsignedHashes, holder, createOfflineTxSigner(t))
+		assert.True(t, errors.Is(err, ErrUnsignedHashMismatch))
+		assert.Empty(t, file.Transactions[0].Transaction.Signature)
+	})
+	t.Run("different number of expected unsigned hashes should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder := createOfflineCryptoHolder(t)
+		file, _ := ExportUnsignedTransactions(testOfflineChainID, createOfflineTransactions(holder.GetBech32(), 2))
+		err := SignOfflineTransactions(file, testOfflineChainID, file.UnsignedHashes()[:1], holder, createOfflineTxSigner(t))
+		assert.True(t, errors.Is(err, ErrUnsignedHashMismatch))
+		assert.Empty(t, file.Transactions[0].Transaction.Signature)
+	})
+	t.Run("already signed should error", func(t *testing.T) {
+		t.Parallel()
+
+		file := createSignedOfflineFile(t)
+		err := SignOfflineTransactions(file, testOfflineChainID, file.UnsignedHashes(), createOfflineCryptoHolder(t), createOfflineTxSigner(t))
+		assert.True(t, errors.Is(err, ErrTransactionAlreadySigned))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		file := createSignedOfflineFile(t)
+		txSigner := createOfflineTxSigner(t)
+		for _, offlineTx := range file.Transactions {
+			assert.NotEmpty(t, offlineTx.Transaction.Signature)
+
+			hash, err := txSigner.ComputeTxHash(offlineTx.Transaction)
+			require.Nil(t, err)
+			assert.Equal(t, hex.EncodeToString(hash), offlineTx.Hash)
+		}
+	})
+}
+
+func TestBroadcastOfflineTransactions(t *testing.T) {
+	t.Parallel()
+
+	createProxy := func(chainID string, sentTxs *[]*transaction.FrontendTransaction, hashes []string) *testsCommon.ProxyStub {
+		return &testsCommon.ProxyStub{
+			GetNetworkConfigCalled: func() (*data.NetworkConfig, error) {
+				return &data.NetworkConfig{ChainID: chainID}, nil
+			},
+			SendTransactionsCalled: func(txs []*transaction.FrontendTransaction) ([]string, error) {
+				*sentTxs = txs
+				return hashes, nil
+			},
+		}
+	}
+
+	t.Run("nil proxy should error", func(t *testing.T) {
+		t.Parallel()
+
+		hashes, err := BroadcastOfflineTransactions(context.Background(), createSignedOfflineFile(t), nil, createOfflineTxSigner(t))
+		assert.Nil(t, hashes)
+		assert.Equal(t, ErrNilProxy, err)
+	})
+	t.Run("nil tx signer should error", func(t *testing.T) {
+		t.Parallel()
+
+		hashes, err := BroadcastOfflineTransactions(context.Background(), createSignedOfflineFile(t), &testsCommon.ProxyStub{}, nil)
+		assert.Nil(t, hashes)
+		assert.Equal(t, ErrNilOfflineTxSigner, err)
+	})
+	t.Run("different network should error", func(t *testing.T) {
+		t.Parallel()
+
+		var sentTxs []*transaction.FrontendTransaction
+		proxy := createProxy("1", &sentTxs, nil)
+		hashes, err := BroadcastOfflineTransactions(context.Background(), createSignedOfflineFile(t), proxy, createOfflineTxSigner(t))
+		assert.Nil(t, hashes)
+		assert.True(t, errors.Is(err, ErrChainIDMismatch))
+		assert.Nil(t, sentTxs)
+	})
+	t.Run("not signed should error", func(t *testing.T) {
+		t.Parallel()
+
+		holder := createOfflineCryptoHolder(t)
+		file, _ := ExportUnsignedTransactions(testOfflineChainID, createOfflineTransactions(holder.GetBech32(), 1))
+		var sentTxs []*transaction.FrontendTransaction
+		proxy := createProxy(testOfflineChainID, &sentTxs, nil)
+		hashes, err := BroadcastOfflineTransactions(context.Background(), file, proxy, createOfflineTxSigner(t))
+		assert.Nil(t, hashes)
+		assert.True(t, errors.Is(err, ErrTransactionNotSigned))
+	})
+	t.Run("altered signed transaction should error", func(t *testing.T) {
+		t.Parallel()
+
+		file := createSignedOfflineFile(t)
+		file.Transactions[0].Transaction.GasLimit++
+		var sentTxs []*transaction.FrontendTransaction
+		proxy := createProxy(testOfflineChainID, &sentTxs, nil)
+		hashes, err := BroadcastOfflineTransactions(context.Background(), file, proxy, createOfflineTxSigner(t))
+		assert.Nil(t, hashes)
+		assert.True(t, errors.Is(err, ErrUnsignedHashMismatch))
+		assert.Nil(t, sentTxs)
+	})
+	t.Run("altered hash should error", func(t *testing.T) {
+		t.Parallel()
+
+		file := createSignedOfflineFile(t)
+		file.Transactions[1].Hash = hex.EncodeToString(make([]byte, 32))
+		var sentTxs []*transaction.FrontendTransaction
+		proxy := createProxy(testOfflineChainID, &sentTxs, nil)
+		hashes, err := BroadcastOfflineTransactions(context.Background(), file, proxy, createOfflineTxSigner(t))
+		assert.Nil(t, hashes)
+		assert.True(t, errors.Is(err, ErrTransactionHashMismatch))
+		assert.Nil(t, sentTxs)
+	})
+	t.Run("different hashes returned by the network should error", func(t *testing.T) {
+		t.Parallel()
+
+		file := createSignedOfflineFile(t)
+		var sentTxs []*transaction.FrontendTransaction
+		proxy := createProxy(testOfflineChainID, &sentTxs, []string{file.Transactions[0].Hash, "other"})
+		_, err := BroadcastOfflineTransactions(context.Background(), file, proxy, createOfflineTxSigner(t))
+		assert.True(t, errors.Is(err, ErrTransactionHashMismatch))
+	})
+	t.Run("should work", func(t *testing.T) {
+		t.Parallel()
+
+		file := createSignedOfflineFile(t)
+		expectedHashes := []string{file.Transactions[0].Hash, file.Transactions[1].Hash}
+		var sentTxs []*transaction.FrontendTransaction
+		proxy := createProxy(testOfflineChainID, &sentTxs, expectedHashes)
+		hashes, err := BroadcastOfflineTransactions(context.Background(), file, proxy, createOfflineTxSigner(t))
+		require.Nil(t, err)
+		assert.Equal(t, expectedHashes, hashes)
+		require.Equal(t, 2, len(sentTxs))
+		assert.Equal(t, file.Transactions[0].Transaction, sentTxs[0])
+	})
+}